@@ -16,7 +16,10 @@
 package aop
 
 import (
+	"fmt"
 	"net/http"
+	"reflect"
+	"sort"
 
 	"github.com/alibaba/ioc-golang/aop/common"
 
@@ -26,22 +29,80 @@ import (
 
 type AOP struct {
 	Name string
+	// Order decides position of interceptors in the chain, interceptor with smaller order is called earlier and wraps
+	// the ones with bigger order. AOPs with the same order are sorted by Name. It can be overwritten by config
+	// 'ioc-golang.aop.order.<Name>'.
+	Order int
+	// Pointcut selects methods that interceptor created by InterceptorFactory applies to, nil matches all methods
+	Pointcut *Pointcut
 	// ConfigLoader is called during ioc.Load() when aop is enabled
 	ConfigLoader func(config *common.Config)
 
-	// InterceptorFactory is called after ConfigLoader is called, when bot aop and debug-server are enabled
+	// InterceptorFactory is called after ConfigLoader is called, when bot aop and debug-server are enabled, it can
+	// return nil if the AOP is disabled by config
 	InterceptorFactory interceptorFactory
+	// AroundInterceptorFactory is called after ConfigLoader is called, when aop is enabled, it can return nil if the
+	// AOP is disabled by config
+	AroundInterceptorFactory aroundInterceptorFactory
 	// RPCInterceptorFactory is called after ConfigLoader is called, when bot aop and debug-server are enabled
 	RPCInterceptorFactory rpcInterceptorFactory
 	// GRPCServiceRegister is called after ConfigLoader is called, when bot aop and debug-server are enabled
 	GRPCServiceRegister gRPCServiceRegister
+	// StopFunc is called during ioc.Stop() when aop is enabled, to flush data and release resources of the AOP
+	StopFunc func()
 }
 
+// Orders of built-in AOPs, interceptors of AOP with default order 0 are called inside all of them
+const (
+	OrderMonitor = -500
+	OrderTrace   = -400
+	OrderLog     = -300
+	OrderWatch   = -200
+	// OrderCache makes cache AOP inside watch and outside transaction, so that cache hits don't begin transactions
+	OrderCache       = -150
+	OrderTransaction = -100
+	// OrderRetry makes retry AOP inside transaction, so that rollback is done once after the last attempt fails
+	OrderRetry = -50
+	// OrderBreaker makes breaker AOP inside retry, so that each attempt is recorded and rejected by the breaker
+	OrderBreaker = -40
+	// OrderLimit makes limit AOP inside breaker, so that calls rejected by open breaker don't take permits
+	OrderLimit = -30
+	// OrderTimeout makes timeout AOP inside limit, so that waiting for permit is not counted in timeout
+	OrderTimeout = -20
+	// OrderRecover makes recover AOP the innermost one, so that all others see panic converted to error
+	OrderRecover = 1 << 20
+)
+
 type Interceptor interface {
 	BeforeInvoke(ctx *InvocationContext)
 	AfterInvoke(ctx *InvocationContext)
 }
 
+/*
+AroundInterceptor wraps invocation of proxied method, next calls the next interceptor in the chain, and the last one
+calls the raw method. It can:
+
+- replace ctx.Params before calling next
+- skip the invocation by not calling next, and return values built by ctx.ReturnValuesWithError
+- substitute return values of next
+
+Returned values must match return types of ctx.MethodType.
+*/
+type AroundInterceptor interface {
+	Invoke(ctx *InvocationContext, next func() []reflect.Value) []reflect.Value
+}
+
+// beforeAfterInterceptor adapts Interceptor to AroundInterceptor
+type beforeAfterInterceptor struct {
+	Interceptor
+}
+
+func (b *beforeAfterInterceptor) Invoke(ctx *InvocationContext, next func() []reflect.Value) []reflect.Value {
+	b.BeforeInvoke(ctx)
+	defer b.AfterInvoke(ctx)
+	return next()
+}
+
 type RPCInterceptor interface {
 	BeforeClientInvoke(req *http.Request) error
 	AfterClientInvoke(rsp *http.Response) error
@@ -50,24 +111,38 @@ type RPCInterceptor interface {
 }
 
 type interceptorFactory func() Interceptor
+type aroundInterceptorFactory func() AroundInterceptor
 type rpcInterceptorFactory func() RPCInterceptor
 type gRPCServiceRegister func(server *grpc.Server)
 
 var aops = make([]AOP, 0)
 
+// namedInterceptor is interceptor created by InterceptorFactory of AOP
+type namedInterceptor struct {
+	name        string
+	order       int
+	pointcut    *Pointcut
+	interceptor AroundInterceptor
+}
+
 var rpcInterceptors []RPCInterceptor
-var interceptors []Interceptor
+var interceptors []*namedInterceptor
 
-var interceptorFactories = make([]interceptorFactory, 0)
 var rpcInterceptorFactories = make([]rpcInterceptorFactory, 0)
 var grpcServiceRegisters = make([]gRPCServiceRegister, 0)
 var configLoaderFuncs = make([]common.ConfigLoader, 0)
+var stopFuncs = make([]func(), 0)
+
+// orderOverrides is order of AOPs set by config, key is AOP name
+var orderOverrides = make(map[string]int)
 
 func RegisterAOP(aopImpl AOP) {
-	aops = append(aops, aopImpl)
-	if aopImpl.InterceptorFactory != nil {
-		interceptorFactories = append(interceptorFactories, aopImpl.InterceptorFactory)
+	if aopImpl.Pointcut != nil {
+		if err := aopImpl.Pointcut.compile(); err != nil {
+			panic(fmt.Sprintf("[AOP] Register AOP %s with invalid pointcut, %s", aopImpl.Name, err))
+		}
 	}
+	aops = append(aops, aopImpl)
 	if aopImpl.RPCInterceptorFactory != nil {
 		rpcInterceptorFactories = append(rpcInterceptorFactories, aopImpl.RPCInterceptorFactory)
 	}
@@ -77,6 +152,9 @@ func RegisterAOP(aopImpl AOP) {
 	if aopImpl.ConfigLoader != nil {
 		configLoaderFuncs = append(configLoaderFuncs, aopImpl.ConfigLoader)
 	}
+	if aopImpl.StopFunc != nil {
+		stopFuncs = append(stopFuncs, aopImpl.StopFunc)
+	}
 }
 
 func GetRPCInterceptors() []RPCInterceptor {
@@ -92,23 +170,90 @@ func GetRPCInterceptors() []RPCInterceptor {
 	return rpcInterceptors
 }
 
-func getInterceptors() []Interceptor {
+func getInterceptors() []*namedInterceptor {
 	if !enabled {
-		return make([]Interceptor, 0)
+		return make([]*namedInterceptor, 0)
 	}
 	if interceptors == nil {
-		interceptors = make([]Interceptor, 0)
-		for _, f := range interceptorFactories {
-			interceptors = append(interceptors, f())
+		interceptors = make([]*namedInterceptor, 0)
+		for _, aopImpl := range getSortedAOPs() {
+			if aopImpl.InterceptorFactory != nil {
+				if interceptor := aopImpl.InterceptorFactory(); interceptor != nil {
+					interceptors = append(interceptors, &namedInterceptor{
+						name:     aopImpl.Name,
+						order:    aopImpl.Order,
+						pointcut: aopImpl.Pointcut,
+						interceptor: &beforeAfterInterceptor{
+							Interceptor: interceptor,
+						},
+					})
+				}
+			}
+			if aopImpl.AroundInterceptorFactory != nil {
+				if interceptor := aopImpl.AroundInterceptorFactory(); interceptor != nil {
+					interceptors = append(interceptors, &namedInterceptor{
+						name:        aopImpl.Name,
+						order:       aopImpl.Order,
+						pointcut:    aopImpl.Pointcut,
+						interceptor: interceptor,
+					})
+				}
+			}
 		}
 	}
 	return interceptors
 }
 
+// getSortedAOPs returns registered AOPs sorted by order and name, with order overwritten by config
+func getSortedAOPs() []AOP {
+	sortedAOPs := make([]AOP, 0, len(aops))
+	for _, aopImpl := range aops {
+		if order, ok := orderOverrides[aopImpl.Name]; ok {
+			aopImpl.Order = order
+		}
+		sortedAOPs = append(sortedAOPs, aopImpl)
+	}
+	sort.SliceStable(sortedAOPs, func(i, j int) bool {
+		if sortedAOPs[i].Order != sortedAOPs[j].Order {
+			return sortedAOPs[i].Order < sortedAOPs[j].Order
+		}
+		return sortedAOPs[i].Name < sortedAOPs[j].Name
+	})
+	return sortedAOPs
+}
+
+// getInterceptorsForMethod returns interceptors whose pointcut matches method of struct
+func getInterceptorsForMethod(sdid, methodName string) []*namedInterceptor {
+	matchedInterceptors := make([]*namedInterceptor, 0)
+	for _, i := range getInterceptors() {
+		if i.pointcut.Match(sdid, methodName) {
+			matchedInterceptors = append(matchedInterceptors, i)
+		}
+	}
+	return matchedInterceptors
+}
+
+// getInterceptorNames returns names of AOPs that interceptors belong to, in the order of chain
+func getInterceptorNames(interceptorImpls []*namedInterceptor) []string {
+	names := make([]string, 0, len(interceptorImpls))
+	for _, i := range interceptorImpls {
+		if len(names) > 0 && names[len(names)-1] == i.name {
+			// one AOP may have both interceptor and around interceptor
+			continue
+		}
+		names = append(names, i.name)
+	}
+	return names
+}
+
 var enabled = false
 
 // enableAOP let all aop load config and set enable to true
 func enableAOP(aopConfig *common.Config) {
+	if aopConfig.Order != nil {
+		orderOverrides = aopConfig.Order
+	}
+
 	// let aop load config
 	for _, cl := range configLoaderFuncs {
 		cl(aopConfig)
@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aop
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetInterceptors_Order(t *testing.T) {
+	tests := []struct {
+		name           string
+		aops           []AOP
+		orderOverrides map[string]int
+		want           []string
+	}{
+		{
+			name: "sort by order",
+			aops: []AOP{
+				{Name: "transaction", Order: OrderTransaction},
+				{Name: "user"},
+				{Name: "trace", Order: OrderTrace},
+				{Name: "monitor", Order: OrderMonitor},
+			},
+			want: []string{"monitor", "trace", "transaction", "user"},
+		},
+		{
+			name: "sort by name with the same order",
+			aops: []AOP{
+				{Name: "b"},
+				{Name: "c"},
+				{Name: "a"},
+			},
+			want: []string{"a", "b", "c"},
+		},
+		{
+			name: "order overwritten by config",
+			aops: []AOP{
+				{Name: "transaction", Order: OrderTransaction},
+				{Name: "user"},
+				{Name: "trace", Order: OrderTrace},
+			},
+			orderOverrides: map[string]int{
+				"user": OrderTrace - 1,
+			},
+			want: []string{"user", "trace", "transaction"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			records := make([]string, 0)
+			aopImpls := make([]AOP, 0, len(tt.aops))
+			for _, aopImpl := range tt.aops {
+				name := aopImpl.Name
+				aopImpl.AroundInterceptorFactory = func() AroundInterceptor {
+					return aroundInterceptorFunc(func(ctx *InvocationContext, next func() []reflect.Value) []reflect.Value {
+						records = append(records, name)
+						return next()
+					})
+				}
+				aopImpls = append(aopImpls, aopImpl)
+			}
+			if tt.orderOverrides != nil {
+				orderOverrides = tt.orderOverrides
+				defer func() {
+					orderOverrides = make(map[string]int)
+				}()
+			}
+			withAOPs(aopImpls, func() {
+				assert.Equal(t, tt.want, getInterceptorNames(getInterceptorsForMethod(proxyTestSDID, "Hello")))
+
+				proxy := &proxyTestImpl_{}
+				assert.Nil(t, implProxy(&proxyTestImpl{}, proxy, proxyTestSDID))
+				_, _ = proxy.Hello_("ioc")
+				assert.Equal(t, tt.want, records)
+				assert.Equal(t, tt.want, GetAllInterfaceMetadata()[proxyTestSDID].MethodMetadata["Hello"].Interceptors)
+			})
+		})
+	}
+}
@@ -62,6 +62,16 @@ func Load() error {
 	return nil
 }
 
+// Stop calls StopFunc of all AOPs in reverse order of registration, if aop is enabled
+func Stop() {
+	if !enabled {
+		return
+	}
+	for i := len(stopFuncs) - 1; i >= 0; i-- {
+		stopFuncs[i]()
+	}
+}
+
 func GetAllInterfaceMetadata() common.AllInterfaceMetadata {
 	return debugMetadata
 }
@@ -19,6 +19,8 @@ type Config struct {
 	AppName     string            `yaml:"app-name"`
 	Disable     bool              `yaml:"disable"`
 	DebugServer DebugServerConfig `yaml:"debug-server"`
+	// Order overwrites order of AOPs, key is AOP name
+	Order map[string]int `yaml:"order"`
 }
 
 type DebugServerConfig struct {
@@ -25,6 +25,8 @@ type StructMetadata struct {
 
 type MethodMetadata struct {
 	Lock sync.Mutex
+	// Interceptors are names of AOPs whose interceptors are applied to the method, in the order of chain
+	Interceptors []string
 }
 
 type AllInterfaceMetadata map[string]*StructMetadata
@@ -22,6 +22,11 @@ import (
 
 const (
 	ProxyMethodPrefix = "github.com/alibaba/ioc-golang/aop."
+
+	// proxyFunctionPrefix and mockProxyFunctionPrefix are prefixes of proxy function closures, each proxied method
+	// invocation goes through one of them once, no matter how many interceptors are in the chain
+	proxyFunctionPrefix     = ProxyMethodPrefix + "makeProxyFunction."
+	mockProxyFunctionPrefix = ProxyMethodPrefix + "GetMockProxyFunctionLayer."
 )
 
 func CurrentCallingMethodName(skip int) string {
@@ -30,19 +35,31 @@ func CurrentCallingMethodName(skip int) string {
 	return runtime.FuncForPC(pc[0]).Name()
 }
 
+// IsTraceEntrance returns true if current invocation is the proxied invocation called by entrance method directly,
+// but not the nested ones
 func IsTraceEntrance(entranceMethodFullName string) bool {
 	pc := make([]uintptr, 500)
 	n := runtime.Callers(0, pc)
+	// frames are used but not pc, because proxy function may be inlined
+	frames := runtime.CallersFrames(pc[:n])
+	fNames := make([]string, 0, n)
+	for {
+		frame, more := frames.Next()
+		fNames = append(fNames, frame.Function)
+		if !more {
+			break
+		}
+	}
 	foundEntrance := false
 	level := int64(0)
 
-	for i := n - 1; i >= 0; i-- {
-		fName := runtime.FuncForPC(pc[i]).Name()
+	for i := len(fNames) - 1; i >= 0; i-- {
+		fName := fNames[i]
 		if foundEntrance {
-			if strings.HasPrefix(fName, ProxyMethodPrefix) {
+			if isProxyFunction(fName) {
 				level++
 			}
-			if level == 3 {
+			if level == 2 {
 				return false
 			}
 			continue
@@ -52,5 +69,16 @@ func IsTraceEntrance(entranceMethodFullName string) bool {
 		}
 	}
 
-	return level-2 == 0
+	return level == 1
+}
+
+// isProxyFunction returns true if fName is the proxy function closure itself, like 'makeProxyFunction.func1', or
+// 'GetMockProxyFunctionLayer.1' if inlined, but not closures nested in it
+func isProxyFunction(fName string) bool {
+	for _, prefix := range []string{proxyFunctionPrefix, mockProxyFunctionPrefix} {
+		if strings.HasPrefix(fName, prefix) {
+			return !strings.Contains(strings.TrimPrefix(fName, prefix), ".")
+		}
+	}
+	return false
 }
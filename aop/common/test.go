@@ -17,6 +17,7 @@ package common
 
 import (
 	"context"
+	"testing"
 )
 
 type User struct {
@@ -39,3 +40,17 @@ func (s *ServiceFoo) Invoke(ctx context.Context, param *RequestParam) (*Response
 		Name: param.User.Name,
 	}, nil
 }
+
+/*
+SetConfigForTest sets package level config of AOP to value, and calls reset to drop state built from the old config.
+The old config is restored and reset is called again when the test finishes.
+*/
+func SetConfigForTest[T any](t testing.TB, config *T, value T, reset func()) {
+	origin := *config
+	*config = value
+	reset()
+	t.Cleanup(func() {
+		*config = origin
+		reset()
+	})
+}
@@ -0,0 +1,51 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aop
+
+import (
+	"context"
+)
+
+/*
+Go runs fn in a new goroutine, and propagates invocation context carried by ctx, or the one running on current
+goroutine if ctx carries none, to it. Proxied methods called in fn are children of the invocation, no matter if ctx
+is passed to them, like:
+
+	func (s *Service) Handle(ctx context.Context, items []string) {
+		for _, item := range items {
+			aop.Go(ctx, func(ctx context.Context) {
+				s.Worker.Process(ctx, item)
+			})
+		}
+	}
+*/
+func Go(ctx context.Context, fn func(ctx context.Context)) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	invocationCtx := GetInvocationCtxFromContext(ctx)
+	if invocationCtx == nil {
+		if invocationCtx = GetCurrentInvocationCtx(); invocationCtx != nil {
+			ctx = WithInvocationCtx(ctx, invocationCtx)
+		}
+	}
+	go func() {
+		if invocationCtx != nil {
+			defer BindCurrentInvocationCtx(invocationCtx)()
+		}
+		fn(ctx)
+	}()
+}
@@ -16,6 +16,7 @@
 package aop
 
 import (
+	"context"
 	"reflect"
 	"sync"
 
@@ -32,32 +33,107 @@ type InvocationContext struct {
 	MethodFullName  string
 	Params          []reflect.Value
 	ReturnValues    []reflect.Value
-	GrID            int64
-	Metadata        map[string]interface{}
+	// MethodType is func type of proxied method, which is nil if context is not created by proxy
+	MethodType reflect.Type
+	// Parent is the invocation that calls current one, found from context.Context param, or from the goroutine
+	Parent *InvocationContext
+	// Context is the first param of method if it's context.Context, which carries current invocation context
+	Context context.Context
+	// GrID is ID of goroutine that the invocation runs on, state of invocation chain should be set by SetValue
+	// instead of keyed by GrID, because invocations of one chain may run on different goroutines
+	GrID     int64
+	Metadata map[string]interface{}
+	// Panic is set by recover AOP if proxied method panics, before converting it to error or panicking again
+	Panic *PanicError
+	// Attempts is count of calling raw method, which is 0 if skipped, or bigger than 1 if retried by interceptors
+	Attempts int
+	// CallRawMethod calls method of the raw instance by name with params like Params, bypassing the proxy and its
+	// interceptors, like fallback called by interceptor. It is set by proxy, and returns false if method is not found.
+	CallRawMethod func(methodName string, params []reflect.Value) ([]reflect.Value, bool)
+
+	// values stores key -> value set by SetValue
+	values sync.Map
+}
+
+// SetValue sets value of key to the invocation, which is visible to the invocation and its descendants by Value, even
+// if they run on other goroutines, like transaction or tracing started by the invocation. It is safe to be called
+// concurrently.
+func (c *InvocationContext) SetValue(key, value interface{}) {
+	c.values.Store(key, value)
+}
+
+// Value returns value of key set to the nearest invocation from c up to the root of its chain, and the invocation
+// that the value is set to, which is c itself if c is the invocation that sets it. nil is returned if not found.
+func (c *InvocationContext) Value(key interface{}) (interface{}, *InvocationContext) {
+	for ; c != nil; c = c.Parent {
+		if value, ok := c.values.Load(key); ok {
+			return value, c
+		}
+	}
+	return nil, nil
 }
 
 func (c *InvocationContext) SetReturnValues(returnValues []reflect.Value) {
 	c.ReturnValues = returnValues
 }
 
+/*
+ReturnValuesWithError builds return values of method with zero values, and err as the last one if the last return
+type is error, it is used by AroundInterceptor to skip the invocation.
+*/
+func (c *InvocationContext) ReturnValuesWithError(err error) []reflect.Value {
+	if c.MethodType == nil {
+		return nil
+	}
+	numOut := c.MethodType.NumOut()
+	returnValues := make([]reflect.Value, numOut)
+	for i := 0; i < numOut; i++ {
+		returnValues[i] = reflect.Zero(c.MethodType.Out(i))
+	}
+	if err != nil && c.ReturnsError() {
+		returnValues[numOut-1] = reflect.ValueOf(&err).Elem()
+	}
+	return returnValues
+}
+
+/*
+ReturnsError returns if the last return type of method is error. If not, the caller can't tell an invocation skipped
+by ReturnValuesWithError from a normal one, and the interceptor should report the skipped invocation itself.
+*/
+func (c *InvocationContext) ReturnsError() bool {
+	if c.MethodType == nil {
+		return false
+	}
+	numOut := c.MethodType.NumOut()
+	return numOut > 0 && c.MethodType.Out(numOut-1) == errorType
+}
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// NewInvocationContext creates invocation context whose parent is the current invocation context of goroutine
 func NewInvocationContext(proxyServicePtr interface{}, sdid, methodName, methodFullName string, params []reflect.Value) *InvocationContext {
-	grID := goid.Get()
-	newInvocationCtx := &InvocationContext{
+	return newInvocationContext(proxyServicePtr, sdid, methodName, methodFullName, params, GetCurrentInvocationCtx())
+}
+
+func newInvocationContext(proxyServicePtr interface{}, sdid, methodName, methodFullName string, params []reflect.Value, parent *InvocationContext) *InvocationContext {
+	return &InvocationContext{
 		ID:              uuid.New(),
 		ProxyServicePtr: proxyServicePtr,
 		SDID:            sdid,
 		Metadata:        make(map[string]interface{}),
 		MethodName:      methodName,
 		Params:          params,
-		GrID:            grID,
+		Parent:          parent,
+		GrID:            goid.Get(),
 		MethodFullName:  methodFullName,
 	}
-	invocationContextMap.Store(grID, newInvocationCtx)
-	return newInvocationCtx
 }
 
+// invocationContextMap stores goroutine-id -> invocation context running on it, which is the fallback of propagating
+// invocation context when context.Context is not passed
 var invocationContextMap = sync.Map{}
 
+// GetCurrentInvocationCtx returns the invocation context running on current goroutine, nil if not in invocation
 func GetCurrentInvocationCtx() *InvocationContext {
 	val, ok := invocationContextMap.Load(goid.Get())
 	if ok {
@@ -65,3 +141,37 @@ func GetCurrentInvocationCtx() *InvocationContext {
 	}
 	return nil
 }
+
+/*
+BindCurrentInvocationCtx sets invocation context running on current goroutine, which is parent of proxied invocations
+on the goroutine without context param, like invocation context created for RPC request on server side. The returned
+function restores the previous one, which must be called on the same goroutine.
+*/
+func BindCurrentInvocationCtx(invocationCtx *InvocationContext) func() {
+	grID := goid.Get()
+	previous, ok := invocationContextMap.Load(grID)
+	invocationContextMap.Store(grID, invocationCtx)
+	return func() {
+		if ok {
+			invocationContextMap.Store(grID, previous)
+			return
+		}
+		invocationContextMap.Delete(grID)
+	}
+}
+
+type invocationCtxKey struct{}
+
+// WithInvocationCtx returns a copy of ctx carrying invocationCtx
+func WithInvocationCtx(ctx context.Context, invocationCtx *InvocationContext) context.Context {
+	return context.WithValue(ctx, invocationCtxKey{}, invocationCtx)
+}
+
+// GetInvocationCtxFromContext returns invocation context carried by ctx, nil if not found
+func GetInvocationCtxFromContext(ctx context.Context) *InvocationContext {
+	if ctx == nil {
+		return nil
+	}
+	invocationCtx, _ := ctx.Value(invocationCtxKey{}).(*InvocationContext)
+	return invocationCtx
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aop
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type propagationTestImpl struct {
+	// Sub is proxy of sub invocation
+	Sub *propagationTestImpl_
+}
+
+func (p *propagationTestImpl) Call(ctx context.Context) *InvocationContext {
+	return GetInvocationCtxFromContext(ctx)
+}
+
+func (p *propagationTestImpl) CallSubInGoroutine(ctx context.Context) *InvocationContext {
+	result := make(chan *InvocationContext)
+	go func() {
+		result <- p.Sub.Call_(ctx)
+	}()
+	return <-result
+}
+
+func (p *propagationTestImpl) CallSubWithoutContext() *InvocationContext {
+	return p.Sub.CallWithoutContext_()
+}
+
+func (p *propagationTestImpl) CallWithoutContext() *InvocationContext {
+	return GetCurrentInvocationCtx()
+}
+
+func (p *propagationTestImpl) GoCallSubWithoutContext() *InvocationContext {
+	result := make(chan *InvocationContext)
+	Go(context.Background(), func(ctx context.Context) {
+		result <- p.Sub.CallWithoutContext_()
+	})
+	return <-result
+}
+
+type propagationTestImpl_ struct {
+	Call_                    func(ctx context.Context) *InvocationContext
+	CallSubInGoroutine_      func(ctx context.Context) *InvocationContext
+	CallSubWithoutContext_   func() *InvocationContext
+	CallWithoutContext_      func() *InvocationContext
+	GoCallSubWithoutContext_ func() *InvocationContext
+}
+
+type propagationTestCtxKey struct{}
+
+const propagationTestSDID = "github.com/alibaba/ioc-golang/aop.propagationTestImpl"
+
+// recordInvocationCtxs records invocation contexts of all proxied invocations
+func recordInvocationCtxs(f func(records *sync.Map)) {
+	records := &sync.Map{}
+	withAOPs([]AOP{{
+		Name: "record",
+		AroundInterceptorFactory: func() AroundInterceptor {
+			return aroundInterceptorFunc(func(ctx *InvocationContext, next func() []reflect.Value) []reflect.Value {
+				records.Store(ctx.MethodName, ctx)
+				return next()
+			})
+		},
+	}}, func() {
+		f(records)
+	})
+}
+
+func newPropagationTestProxy(t *testing.T) *propagationTestImpl_ {
+	sub := &propagationTestImpl_{}
+	assert.Nil(t, implProxy(&propagationTestImpl{}, sub, propagationTestSDID))
+	proxy := &propagationTestImpl_{}
+	assert.Nil(t, implProxy(&propagationTestImpl{Sub: sub}, proxy, propagationTestSDID))
+	return proxy
+}
+
+func TestInvocationContextPropagation(t *testing.T) {
+	t.Run("by context param", func(t *testing.T) {
+		recordInvocationCtxs(func(records *sync.Map) {
+			proxy := newPropagationTestProxy(t)
+			callerCtx := context.WithValue(context.Background(), propagationTestCtxKey{}, "value")
+			invocationCtx := proxy.Call_(callerCtx)
+			assert.NotNil(t, invocationCtx)
+			assert.Nil(t, invocationCtx.Parent)
+			assert.Equal(t, "value", invocationCtx.Context.Value(propagationTestCtxKey{}))
+
+			record, _ := records.Load("Call")
+			assert.Equal(t, record, invocationCtx)
+			assert.Nil(t, GetCurrentInvocationCtx())
+		})
+	})
+
+	t.Run("by context param across goroutines", func(t *testing.T) {
+		recordInvocationCtxs(func(records *sync.Map) {
+			proxy := newPropagationTestProxy(t)
+			subInvocationCtx := proxy.CallSubInGoroutine_(context.Background())
+			parent, _ := records.Load("CallSubInGoroutine")
+			assert.Equal(t, parent, subInvocationCtx.Parent)
+			assert.NotEqual(t, parent.(*InvocationContext).GrID, subInvocationCtx.GrID)
+		})
+	})
+
+	t.Run("by goroutine", func(t *testing.T) {
+		recordInvocationCtxs(func(records *sync.Map) {
+			proxy := newPropagationTestProxy(t)
+			subInvocationCtx := proxy.CallSubWithoutContext_()
+			parent, _ := records.Load("CallSubWithoutContext")
+			assert.Equal(t, parent, subInvocationCtx.Parent)
+			assert.Nil(t, parent.(*InvocationContext).Parent)
+			// invocation context of goroutine is restored after invocation
+			assert.Nil(t, GetCurrentInvocationCtx())
+		})
+	})
+
+	t.Run("by aop.Go", func(t *testing.T) {
+		recordInvocationCtxs(func(records *sync.Map) {
+			proxy := newPropagationTestProxy(t)
+			subInvocationCtx := proxy.GoCallSubWithoutContext_()
+			parent, _ := records.Load("GoCallSubWithoutContext")
+			assert.Equal(t, parent, subInvocationCtx.Parent)
+			assert.NotEqual(t, parent.(*InvocationContext).GrID, subInvocationCtx.GrID)
+		})
+	})
+}
+
+func TestInvocationContext_Value(t *testing.T) {
+	type key struct{}
+	root := newInvocationContext(nil, propagationTestSDID, "Call", "", nil, nil)
+	child := newInvocationContext(nil, propagationTestSDID, "CallSub", "", nil, root)
+	grandChild := newInvocationContext(nil, propagationTestSDID, "CallSub", "", nil, child)
+
+	value, owner := grandChild.Value(key{})
+	assert.Nil(t, value)
+	assert.Nil(t, owner)
+
+	root.SetValue(key{}, "root")
+	value, owner = grandChild.Value(key{})
+	assert.Equal(t, "root", value)
+	assert.Equal(t, root, owner)
+
+	child.SetValue(key{}, "child")
+	value, owner = grandChild.Value(key{})
+	assert.Equal(t, "child", value)
+	assert.Equal(t, child, owner)
+	value, owner = root.Value(key{})
+	assert.Equal(t, "root", value)
+	assert.Equal(t, root, owner)
+}
+
+func TestGo(t *testing.T) {
+	invocationCtx := NewInvocationContext(nil, propagationTestSDID, "Call", "", nil)
+	defer BindCurrentInvocationCtx(invocationCtx)()
+
+	result := make(chan [2]*InvocationContext)
+	Go(context.Background(), func(ctx context.Context) {
+		result <- [2]*InvocationContext{GetInvocationCtxFromContext(ctx), GetCurrentInvocationCtx()}
+	})
+	invocationCtxs := <-result
+	assert.Equal(t, invocationCtx, invocationCtxs[0])
+	assert.Equal(t, invocationCtx, invocationCtxs[1])
+	assert.Equal(t, invocationCtx, GetCurrentInvocationCtx())
+}
@@ -15,7 +15,11 @@
 
 package aop
 
-import "github.com/alibaba/ioc-golang/autowire"
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/alibaba/ioc-golang/autowire"
+)
 
 const MetadataKey = "aop"
 
@@ -32,3 +36,36 @@ func ParseAOPMetadataFromSDMetadata(metadata autowire.Metadata) Metadata {
 	}
 	return nil
 }
+
+/*
+ParseMethodRuleFromSDMetadata parses rule of method generated from AOP marker like '+ioc:aop:retry:method=GetUser,...',
+which is like "retry": map[string]map[string]interface{}{"GetUser": {"max-attempts": 5}}, into rule by its yaml tags.
+It returns false if the method has no rule of the AOP.
+*/
+func ParseMethodRuleFromSDMetadata(metadata autowire.Metadata, aopName, methodName string, rule interface{}) (bool, error) {
+	aopMetadata := ParseAOPMetadataFromSDMetadata(metadata)
+	if aopMetadata == nil {
+		return false, nil
+	}
+	methodRules, ok := aopMetadata[aopName].(map[string]map[string]interface{})
+	if !ok {
+		return false, nil
+	}
+	methodRule, ok := methodRules[methodName]
+	if !ok {
+		return false, nil
+	}
+	return true, DecodeMethodRule(methodRule, rule)
+}
+
+/*
+DecodeMethodRule decodes fields of rawRule into rule by its yaml tags, fields of rule not in rawRule are kept, so that
+rule can be pre-filled with defaults, and decoded from marker and config in order, with explicit zero values kept.
+*/
+func DecodeMethodRule(rawRule map[string]interface{}, rule interface{}) error {
+	ruleBytes, err := yaml.Marshal(rawRule)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(ruleBytes, rule)
+}
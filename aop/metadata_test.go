@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aop
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMethodRuleFromSDMetadata(t *testing.T) {
+	type rule struct {
+		MaxAttempts int           `yaml:"max-attempts"`
+		Backoff     time.Duration `yaml:"backoff"`
+	}
+	metadata := map[string]interface{}{
+		"aop": map[string]interface{}{
+			"retry": map[string]map[string]interface{}{
+				"Get": {
+					"max-attempts": 3,
+					"backoff":      "50ms",
+				},
+				"Invalid": {
+					"backoff": "invalid",
+				},
+			},
+		},
+	}
+
+	r := &rule{}
+	ok, err := ParseMethodRuleFromSDMetadata(metadata, "retry", "Get", r)
+	assert.True(t, ok)
+	assert.Nil(t, err)
+	assert.Equal(t, &rule{MaxAttempts: 3, Backoff: time.Millisecond * 50}, r)
+
+	ok, err = ParseMethodRuleFromSDMetadata(metadata, "retry", "Invalid", &rule{})
+	assert.True(t, ok)
+	assert.NotNil(t, err)
+
+	ok, err = ParseMethodRuleFromSDMetadata(metadata, "retry", "NotMarked", &rule{})
+	assert.False(t, ok)
+	assert.Nil(t, err)
+	ok, err = ParseMethodRuleFromSDMetadata(metadata, "limit", "Get", &rule{})
+	assert.False(t, ok)
+	assert.Nil(t, err)
+	ok, err = ParseMethodRuleFromSDMetadata(nil, "retry", "Get", &rule{})
+	assert.False(t, ok)
+	assert.Nil(t, err)
+}
+
+func TestDecodeMethodRule(t *testing.T) {
+	type rule struct {
+		Rate  float64 `yaml:"rate"`
+		Burst int     `yaml:"burst"`
+		Block bool    `yaml:"block"`
+	}
+	r := &rule{Rate: 10, Burst: 20, Block: true}
+	assert.Nil(t, DecodeMethodRule(map[string]interface{}{"rate": 0, "block": false}, r))
+	assert.Equal(t, &rule{Burst: 20}, r)
+	assert.Nil(t, DecodeMethodRule(nil, r))
+	assert.Equal(t, &rule{Burst: 20}, r)
+	assert.NotNil(t, DecodeMethodRule(map[string]interface{}{"burst": "invalid"}, r))
+}
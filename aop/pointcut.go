@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aop
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/alibaba/ioc-golang/autowire"
+)
+
+/*
+Pointcut selects methods of proxied structs that interceptor of one AOP applies to. All non-empty conditions must
+match, and nil or empty Pointcut matches all methods, like:
+
+	aop.RegisterAOP(aop.AOP{
+		Name: "my-aop",
+		Pointcut: &aop.Pointcut{
+			SDIDs:   []string{"github.com/my/app/service.*"},
+			Methods: "^(Get|List)",
+		},
+		InterceptorFactory: ...,
+	})
+*/
+type Pointcut struct {
+	// SDIDs are glob patterns of struct descriptor ID, '*' matches any characters, '?' matches one character.
+	// Struct matches if any of them matches.
+	SDIDs []string
+	// Methods is regular expression of method name
+	Methods string
+	// Marker matches struct marked with '+ioc:aop:<Marker>', whose aop metadata contains key Marker, which is
+	// generated by code generation plugin of the AOP, or generated as '"<Marker>": true' by iocli if the AOP has no
+	// plugin.
+	Marker string
+	// Matcher is custom matcher of struct descriptor and method, sd is nil if struct descriptor is not registered
+	Matcher func(sd *autowire.StructDescriptor, methodName string) bool
+
+	compileOnce   sync.Once
+	compileErr    error
+	sdidPatterns  []*regexp.Regexp
+	methodPattern *regexp.Regexp
+}
+
+// compile compiles patterns of pointcut only once, which is called when AOP is registered, and before the first match
+// if pointcut is used without registering. Fields of pointcut should not be modified after that.
+func (p *Pointcut) compile() error {
+	p.compileOnce.Do(func() {
+		p.compileErr = p.doCompile()
+	})
+	return p.compileErr
+}
+
+func (p *Pointcut) doCompile() error {
+	p.sdidPatterns = make([]*regexp.Regexp, 0, len(p.SDIDs))
+	for _, sdid := range p.SDIDs {
+		sdidPattern, err := regexp.Compile(globToRegexp(sdid))
+		if err != nil {
+			return fmt.Errorf("invalid sdid glob %s, error = %s", sdid, err)
+		}
+		p.sdidPatterns = append(p.sdidPatterns, sdidPattern)
+	}
+	if p.Methods != "" {
+		methodPattern, err := regexp.Compile(p.Methods)
+		if err != nil {
+			return fmt.Errorf("invalid method regular expression %s, error = %s", p.Methods, err)
+		}
+		p.methodPattern = methodPattern
+	}
+	return nil
+}
+
+// Match returns if method of struct is selected by pointcut
+func (p *Pointcut) Match(sdid, methodName string) bool {
+	if p == nil {
+		return true
+	}
+	if err := p.compile(); err != nil {
+		return false
+	}
+	if len(p.sdidPatterns) > 0 {
+		sdidMatched := false
+		for _, sdidPattern := range p.sdidPatterns {
+			if sdidPattern.MatchString(sdid) {
+				sdidMatched = true
+				break
+			}
+		}
+		if !sdidMatched {
+			return false
+		}
+	}
+	if p.methodPattern != nil && !p.methodPattern.MatchString(methodName) {
+		return false
+	}
+	if p.Marker == "" && p.Matcher == nil {
+		return true
+	}
+	sd := autowire.GetStructDescriptor(sdid)
+	if p.Marker != "" {
+		if sd == nil {
+			return false
+		}
+		if _, ok := ParseAOPMetadataFromSDMetadata(sd.Metadata)[p.Marker]; !ok {
+			return false
+		}
+	}
+	return p.Matcher == nil || p.Matcher(sd, methodName)
+}
+
+func globToRegexp(glob string) string {
+	builder := strings.Builder{}
+	builder.WriteString("^")
+	for _, c := range glob {
+		switch c {
+		case '*':
+			builder.WriteString(".*")
+		case '?':
+			builder.WriteString(".")
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	builder.WriteString("$")
+	return builder.String()
+}
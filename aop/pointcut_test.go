@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aop
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/autowire"
+)
+
+const (
+	pointcutMarkedSDID   = "github.com/alibaba/ioc-golang/aop.pointcutMarkedImpl"
+	pointcutUnmarkedSDID = "github.com/alibaba/ioc-golang/aop.pointcutUnmarkedImpl"
+)
+
+func init() {
+	autowire.RegisterStructDescriptor(&autowire.StructDescriptor{
+		SDID: pointcutMarkedSDID,
+		Metadata: map[string]interface{}{
+			MetadataKey: map[string]interface{}{
+				"retry": map[string]interface{}{},
+			},
+		},
+	})
+	autowire.RegisterStructDescriptor(&autowire.StructDescriptor{
+		SDID:     pointcutUnmarkedSDID,
+		Metadata: map[string]interface{}{},
+	})
+}
+
+func TestPointcut_Match(t *testing.T) {
+	tests := []struct {
+		name       string
+		pointcut   *Pointcut
+		sdid       string
+		methodName string
+		want       bool
+	}{
+		{"nil pointcut", nil, pointcutUnmarkedSDID, "Get", true},
+		{"empty pointcut", &Pointcut{}, pointcutUnmarkedSDID, "Get", true},
+		{"sdid glob matches", &Pointcut{SDIDs: []string{"github.com/alibaba/*.pointcut*Impl"}}, pointcutUnmarkedSDID, "Get", true},
+		{"sdid glob matches one of", &Pointcut{SDIDs: []string{"github.com/other/*", "*.pointcutUnmarkedImpl"}}, pointcutUnmarkedSDID, "Get", true},
+		{"sdid glob not matches", &Pointcut{SDIDs: []string{"github.com/other/*"}}, pointcutUnmarkedSDID, "Get", false},
+		{"sdid glob with single char", &Pointcut{SDIDs: []string{"*.pointcut?nmarkedImpl"}}, pointcutUnmarkedSDID, "Get", true},
+		{"sdid glob quotes meta", &Pointcut{SDIDs: []string{"github+com/*"}}, pointcutUnmarkedSDID, "Get", false},
+		{"method regexp matches", &Pointcut{Methods: "^(Get|List)"}, pointcutUnmarkedSDID, "GetUser", true},
+		{"method regexp not matches", &Pointcut{Methods: "^(Get|List)"}, pointcutUnmarkedSDID, "SetUser", false},
+		{"sdid and method must both match", &Pointcut{SDIDs: []string{"*Unmarked*"}, Methods: "^Get$"}, pointcutUnmarkedSDID, "List", false},
+		{"marker matches", &Pointcut{Marker: "retry"}, pointcutMarkedSDID, "Get", true},
+		{"marker not matches", &Pointcut{Marker: "retry"}, pointcutUnmarkedSDID, "Get", false},
+		{"marker of unregistered struct", &Pointcut{Marker: "retry"}, "github.com/alibaba/ioc-golang/aop.notFound", "Get", false},
+		{"matcher matches", &Pointcut{Matcher: func(sd *autowire.StructDescriptor, methodName string) bool {
+			return sd != nil && methodName == "Get"
+		}}, pointcutUnmarkedSDID, "Get", true},
+		{"matcher not matches", &Pointcut{Matcher: func(sd *autowire.StructDescriptor, methodName string) bool {
+			return methodName == "Get"
+		}}, pointcutUnmarkedSDID, "Set", false},
+		{"invalid method regexp", &Pointcut{Methods: "("}, pointcutUnmarkedSDID, "Get", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.pointcut.Match(tt.sdid, tt.methodName))
+		})
+	}
+}
+
+func TestPointcut_MatchConcurrently(t *testing.T) {
+	pointcut := &Pointcut{SDIDs: []string{"*.pointcutUnmarkedImpl"}, Methods: "^Get"}
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.True(t, pointcut.Match(pointcutUnmarkedSDID, "GetUser"))
+		}()
+	}
+	wg.Wait()
+}
+
+type mockInterceptor struct {
+}
+
+func (m *mockInterceptor) BeforeInvoke(ctx *InvocationContext) {
+}
+
+func (m *mockInterceptor) AfterInvoke(ctx *InvocationContext) {
+}
+
+func TestGetInterceptorsForMethod(t *testing.T) {
+	withAOPs([]AOP{
+		{
+			Name: "pointcut-all",
+			InterceptorFactory: func() Interceptor {
+				return &mockInterceptor{}
+			},
+		},
+		{
+			Name:     "pointcut-marked",
+			Pointcut: &Pointcut{Marker: "retry"},
+			InterceptorFactory: func() Interceptor {
+				return &mockInterceptor{}
+			},
+		},
+	}, func() {
+		names := func(interceptors []*namedInterceptor) []string {
+			result := make([]string, 0)
+			for _, i := range interceptors {
+				result = append(result, i.name)
+			}
+			return result
+		}
+		assert.Equal(t, []string{"pointcut-all", "pointcut-marked"}, names(getInterceptorsForMethod(pointcutMarkedSDID, "Get")))
+		assert.Equal(t, []string{"pointcut-all"}, names(getInterceptorsForMethod(pointcutUnmarkedSDID, "Get")))
+	})
+}
+
+func TestRegisterAOP_invalidPointcut(t *testing.T) {
+	assert.Panics(t, func() {
+		RegisterAOP(AOP{
+			Name:     "invalid-pointcut",
+			Pointcut: &Pointcut{Methods: "("},
+		})
+	})
+}
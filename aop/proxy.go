@@ -16,6 +16,7 @@
 package aop
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"strings"
@@ -29,91 +30,156 @@ import (
 func init() {
 	autowire.RegisterProxyFunction(proxyFunction)
 	autowire.RegisterProxyImplFunction(implProxy)
+	autowire.RegisterReplaceableProxyFunction(replaceableProxyFunction)
 }
 
 func proxyFunction(rawPtr interface{}) interface{} {
+	proxyPtr, _ := replaceableProxyFunction(rawPtr)
+	return proxyPtr
+}
+
+// replaceableProxyFunction wraps rawPtr with proxy, and returns function to replace raw instance behind the proxy
+func replaceableProxyFunction(rawPtr interface{}) (interface{}, func(interface{}) <-chan struct{}) {
 	sdid := util.GetSDIDByStructPtr(rawPtr)
 	proxySDID := util.GetProxySDIDByStructPtr(rawPtr)
 	proxyStructPtr, err := normal.GetImpl(proxySDID, nil)
 	if err != nil {
-		return rawPtr
+		return rawPtr, nil
 	}
 
-	if err := implProxy(rawPtr, proxyStructPtr, sdid); err != nil {
-		return rawPtr
+	holder, err := implProxyWithHolder(rawPtr, proxyStructPtr, sdid)
+	if err != nil {
+		return rawPtr, nil
 	}
-	return proxyStructPtr
+	return proxyStructPtr, holder.replace
 }
 
 func implProxy(rawServicePtr, proxyPtr interface{}, sdid string) error {
-	debugMetadataLock.Lock()
-	if _, ok := debugMetadata[sdid]; !ok {
-		debugMetadata[sdid] = &common.StructMetadata{
-			MethodMetadata: map[string]*common.MethodMetadata{},
-		}
-	}
-	debugMetadataLock.Unlock()
+	_, err := implProxyWithHolder(rawServicePtr, proxyPtr, sdid)
+	return err
+}
 
+// implProxyWithHolder sets proxy functions calling raw instance held by returned holder
+func implProxyWithHolder(rawServicePtr, proxyPtr interface{}, sdid string) (*proxyTargetHolder, error) {
 	valueOf := reflect.ValueOf(proxyPtr)
 	valueOfElem := valueOf.Elem()
 	typeOfElem := valueOfElem.Type()
 	if typeOfElem.Kind() != reflect.Struct {
-		return fmt.Errorf("invalid struct ptr %+v", proxyPtr)
+		return nil, fmt.Errorf("invalid struct ptr %+v", proxyPtr)
 	}
+	holder := newProxyTargetHolder(rawServicePtr, typeOfElem)
 
-	valueOfRaw := reflect.ValueOf(rawServicePtr)
-	valueOfRawElem := valueOfRaw.Elem()
+	debugMetadataLock.Lock()
+	if _, ok := debugMetadata[sdid]; !ok {
+		debugMetadata[sdid] = &common.StructMetadata{
+			MethodMetadata: map[string]*common.MethodMetadata{},
+		}
+	}
+	debugMetadataLock.Unlock()
 
 	numField := valueOfElem.NumField()
 	for i := 0; i < numField; i++ {
 		methodType := typeOfElem.Field(i)
 		f := valueOfElem.Field(i)
 		rawMethodName := strings.TrimSuffix(methodType.Name, "_")
-		funcRaw := valueOfRaw.MethodByName(rawMethodName)
-		if !funcRaw.IsValid() {
-			funcRaw = valueOfRawElem.FieldByName(rawMethodName)
-		}
 		// each method of one type should only injected once
 		if f.Kind() == reflect.Func && f.IsValid() && f.CanSet() {
+			// interceptors may be created here, which creates proxies of themselves, so get them before locking
+			interceptorNames := getInterceptorNames(getInterceptorsForMethod(sdid, rawMethodName))
 			debugMetadataLock.Lock()
-			debugMetadata[sdid].MethodMetadata[rawMethodName] = &common.MethodMetadata{}
+			debugMetadata[sdid].MethodMetadata[rawMethodName] = &common.MethodMetadata{
+				Interceptors: interceptorNames,
+			}
 			debugMetadataLock.Unlock()
-			f.Set(reflect.MakeFunc(methodType.Type, makeProxyFunction(proxyPtr, funcRaw, sdid, rawMethodName, methodType.Type.IsVariadic())))
+			f.Set(reflect.MakeFunc(methodType.Type, makeProxyFunction(proxyPtr, holder, sdid, rawMethodName, methodType.Type)))
 		}
 	}
-	return nil
+	return holder, nil
 }
 
-func makeProxyFunction(proxyPtr interface{}, rf reflect.Value, sdid, methodName string, isVariadic bool) func(in []reflect.Value) []reflect.Value {
-	rawFunction := rf
-	interceptorImpls := getInterceptors()
+func makeProxyFunction(proxyPtr interface{}, holder *proxyTargetHolder, sdid, methodName string, methodType reflect.Type) func(in []reflect.Value) []reflect.Value {
+	isVariadic := methodType.IsVariadic()
+	hasContextParam := methodType.NumIn() > 0 && methodType.In(0) == contextType
+	// bind interceptors matching the method when proxy is created
+	interceptorImpls := getInterceptorsForMethod(sdid, methodName)
 	proxyFunc := func(in []reflect.Value) []reflect.Value {
-		invocationCtx := NewInvocationContext(proxyPtr, sdid, methodName, common.CurrentCallingMethodName(3), in)
-
-		for _, i := range interceptorImpls {
-			i.BeforeInvoke(invocationCtx)
+		// find parent invocation from context param first, and then from current goroutine
+		var callerCtx context.Context
+		var parent *InvocationContext
+		if hasContextParam && !in[0].IsNil() {
+			callerCtx = in[0].Interface().(context.Context)
+			parent = GetInvocationCtxFromContext(callerCtx)
 		}
-
-		defer func() {
-			for _, i := range interceptorImpls {
-				i.AfterInvoke(invocationCtx)
+		if parent == nil {
+			parent = GetCurrentInvocationCtx()
+		}
+		invocationCtx := newInvocationContext(proxyPtr, sdid, methodName, common.CurrentCallingMethodName(3), in, parent)
+		invocationCtx.MethodType = methodType
+		invocationCtx.CallRawMethod = holder.callMethod
+		if callerCtx != nil {
+			// pass current invocation context to raw method by context param
+			invocationCtx.Context = WithInvocationCtx(callerCtx, invocationCtx)
+			in[0] = reflect.ValueOf(&invocationCtx.Context).Elem()
+		}
+		defer BindCurrentInvocationCtx(invocationCtx)()
+
+		out := invokeChain(invocationCtx, interceptorImpls, 0, func() []reflect.Value {
+			invocationCtx.Attempts++
+			// params may be replaced by around interceptors
+			params := invocationCtx.Params
+			if isVariadic {
+				varParam := params[len(params)-1]
+				params = append(make([]reflect.Value, 0, len(params)+varParam.Len()), params[:len(params)-1]...)
+				for j, l := 0, varParam.Len(); j < l; j++ {
+					params = append(params, varParam.Index(j))
+				}
 			}
-		}()
+			// raw instance may be replaced, like refreshed singleton, so resolve it on each call
+			target := holder.acquire()
+			defer target.release()
+			return target.methods[methodName].Call(params)
+		})
+		out = normalizeReturnValues(out, methodType)
+		invocationCtx.SetReturnValues(out)
+		return out
+	}
+	return proxyFunc
+}
 
-		if isVariadic {
-			varParam := in[len(in)-1]
-			in = in[:len(in)-1]
-			for j, l := 0, varParam.Len(); j < l; j++ {
-				in = append(in, varParam.Index(j))
-			}
-		}
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
 
-		out := rawFunction.Call(in)
-		invocationCtx.SetReturnValues(out)
+// normalizeReturnValues converts return values replaced by interceptors to return types of method, like converting
+// *errors.fundamental to error, and invalid value to zero value
+func normalizeReturnValues(out []reflect.Value, methodType reflect.Type) []reflect.Value {
+	for i := range out {
+		if i >= methodType.NumOut() {
+			break
+		}
+		outType := methodType.Out(i)
+		if !out[i].IsValid() {
+			out[i] = reflect.Zero(outType)
+		} else if out[i].Type() != outType && out[i].Type().AssignableTo(outType) {
+			converted := reflect.New(outType).Elem()
+			converted.Set(out[i])
+			out[i] = converted
+		}
+	}
+	return out
+}
 
+// invokeChain calls interceptors from index in order, the last one calls the raw function
+func invokeChain(ctx *InvocationContext, interceptors []*namedInterceptor, index int, call func() []reflect.Value) []reflect.Value {
+	if index == len(interceptors) {
+		out := call()
+		ctx.SetReturnValues(out)
 		return out
 	}
-	return proxyFunc
+	return interceptors[index].interceptor.Invoke(ctx, func() []reflect.Value {
+		out := invokeChain(ctx, interceptors, index+1, call)
+		// return values may be replaced by inner around interceptors
+		ctx.SetReturnValues(out)
+		return out
+	})
 }
 
 func GetMockProxyFunctionLayer() func(func()) {
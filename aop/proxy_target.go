@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package aop
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// proxyTarget is raw instance called by proxy functions, with its methods resolved by proxy method names
+type proxyTarget struct {
+	methods map[string]reflect.Value
+
+	// inFlight is count of calls to the instance, replaced is set to 1 when another instance replaces it, and drained
+	// is closed when all in-flight calls of replaced instance finish
+	inFlight    int64
+	replaced    int32
+	drained     chan struct{}
+	drainedOnce sync.Once
+}
+
+func newProxyTarget(rawServicePtr interface{}, proxyElemType reflect.Type) *proxyTarget {
+	valueOfRaw := reflect.ValueOf(rawServicePtr)
+	valueOfRawElem := valueOfRaw.Elem()
+	methods := make(map[string]reflect.Value)
+	for i := 0; i < proxyElemType.NumField(); i++ {
+		rawMethodName := strings.TrimSuffix(proxyElemType.Field(i).Name, "_")
+		funcRaw := valueOfRaw.MethodByName(rawMethodName)
+		if !funcRaw.IsValid() && valueOfRawElem.Kind() == reflect.Struct {
+			funcRaw = valueOfRawElem.FieldByName(rawMethodName)
+		}
+		methods[rawMethodName] = funcRaw
+	}
+	return &proxyTarget{
+		methods: methods,
+		drained: make(chan struct{}),
+	}
+}
+
+func (t *proxyTarget) release() {
+	if atomic.AddInt64(&t.inFlight, -1) == 0 && atomic.LoadInt32(&t.replaced) == 1 {
+		t.drainedOnce.Do(func() { close(t.drained) })
+	}
+}
+
+// retire marks the target replaced, the returned channel is closed when all in-flight calls finish
+func (t *proxyTarget) retire() <-chan struct{} {
+	atomic.StoreInt32(&t.replaced, 1)
+	if atomic.LoadInt64(&t.inFlight) == 0 {
+		t.drainedOnce.Do(func() { close(t.drained) })
+	}
+	return t.drained
+}
+
+// proxyTargetHolder holds current target of one proxy, which is swapped atomically, so that the instance behind
+// proxy can be replaced while it is being called
+type proxyTargetHolder struct {
+	current       atomic.Value
+	proxyElemType reflect.Type
+}
+
+func newProxyTargetHolder(rawServicePtr interface{}, proxyElemType reflect.Type) *proxyTargetHolder {
+	holder := &proxyTargetHolder{
+		proxyElemType: proxyElemType,
+	}
+	holder.current.Store(newProxyTarget(rawServicePtr, proxyElemType))
+	return holder
+}
+
+// acquire returns current target with its in-flight count increased, release of target should be called after the
+// call finishes
+func (h *proxyTargetHolder) acquire() *proxyTarget {
+	for {
+		target := h.current.Load().(*proxyTarget)
+		atomic.AddInt64(&target.inFlight, 1)
+		if atomic.LoadInt32(&target.replaced) == 0 {
+			return target
+		}
+		// replaced after loaded, try the new one
+		target.release()
+	}
+}
+
+// callMethod calls method of current target by proxy method name, params of variadic method are like Params of
+// InvocationContext, whose last one is slice of variadic params
+func (h *proxyTargetHolder) callMethod(methodName string, params []reflect.Value) ([]reflect.Value, bool) {
+	target := h.acquire()
+	defer target.release()
+	method, ok := target.methods[methodName]
+	if !ok {
+		return nil, false
+	}
+	if method.Type().IsVariadic() {
+		return method.CallSlice(params), true
+	}
+	return method.Call(params), true
+}
+
+// replace swaps target to new raw instance without resetting proxy functions, so that calls in flight keep calling the
+// old instance, and new calls are redirected to the new one. The returned channel is closed when all in-flight calls
+// of the old instance finish.
+func (h *proxyTargetHolder) replace(rawServicePtr interface{}) <-chan struct{} {
+	return h.current.Swap(newProxyTarget(rawServicePtr, h.proxyElemType)).(*proxyTarget).retire()
+}
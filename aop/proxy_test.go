@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package aop
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type proxyTestImpl struct {
+	calls []string
+}
+
+func (p *proxyTestImpl) Hello(name string) (string, error) {
+	p.calls = append(p.calls, "Hello")
+	return "hello " + name, nil
+}
+
+func (p *proxyTestImpl) Sum(base int, nums ...int) int {
+	for _, num := range nums {
+		base += num
+	}
+	return base
+}
+
+type proxyTestImpl_ struct {
+	Hello_ func(name string) (string, error)
+	Sum_   func(base int, nums ...int) int
+}
+
+const proxyTestSDID = "github.com/alibaba/ioc-golang/aop.proxyTestImpl"
+
+// withAOPs enables aop with only given aops registered
+func withAOPs(aopImpls []AOP, f func()) {
+	lastAOPs := aops
+	aops = make([]AOP, 0)
+	for _, aopImpl := range aopImpls {
+		RegisterAOP(aopImpl)
+	}
+	enabled = true
+	interceptors = nil
+	defer func() {
+		aops = lastAOPs
+		enabled = false
+		interceptors = nil
+	}()
+	f()
+}
+
+type recordInterceptor struct {
+	name    string
+	records *[]string
+}
+
+func (r *recordInterceptor) BeforeInvoke(ctx *InvocationContext) {
+	*r.records = append(*r.records, "before "+r.name)
+}
+
+func (r *recordInterceptor) AfterInvoke(ctx *InvocationContext) {
+	*r.records = append(*r.records, "after "+r.name+" "+ctx.ReturnValues[0].String())
+}
+
+type aroundInterceptorFunc func(ctx *InvocationContext, next func() []reflect.Value) []reflect.Value
+
+func (f aroundInterceptorFunc) Invoke(ctx *InvocationContext, next func() []reflect.Value) []reflect.Value {
+	return f(ctx, next)
+}
+
+func TestMakeProxyFunction(t *testing.T) {
+	records := make([]string, 0)
+	withAOPs([]AOP{
+		{
+			Name: "record",
+			InterceptorFactory: func() Interceptor {
+				return &recordInterceptor{name: "record", records: &records}
+			},
+		},
+		{
+			Name:     "rewrite",
+			Pointcut: &Pointcut{Methods: "^Hello$"},
+			AroundInterceptorFactory: func() AroundInterceptor {
+				return aroundInterceptorFunc(func(ctx *InvocationContext, next func() []reflect.Value) []reflect.Value {
+					name := ctx.Params[0].String()
+					switch {
+					case name == "skip":
+						records = append(records, "skip")
+						return ctx.ReturnValuesWithError(errors.New("skipped"))
+					case strings.HasPrefix(name, "upper-"):
+						ctx.Params[0] = reflect.ValueOf(strings.ToUpper(strings.TrimPrefix(name, "upper-")))
+					case name == "twice":
+						next()
+					case name == "raw":
+						// raw method is called without interceptors
+						sum, ok := ctx.CallRawMethod("Sum", []reflect.Value{reflect.ValueOf(1), reflect.ValueOf([]int{2, 3})})
+						records = append(records, fmt.Sprintf("raw sum %d %t", sum[0].Int(), ok))
+						_, ok = ctx.CallRawMethod("NotExist", nil)
+						records = append(records, fmt.Sprintf("raw not exist %t", ok))
+					}
+					out := next()
+					records = append(records, fmt.Sprintf("attempts %d", ctx.Attempts))
+					if name == "replace" {
+						return []reflect.Value{reflect.ValueOf("replaced"), reflect.ValueOf(errors.New("replaced"))}
+					}
+					return out
+				})
+			},
+		},
+	}, func() {
+		raw := &proxyTestImpl{}
+		proxy := &proxyTestImpl_{}
+		assert.Nil(t, implProxy(raw, proxy, proxyTestSDID))
+
+		t.Run("test before after interceptor adapted to around", func(t *testing.T) {
+			records = records[:0]
+			result, err := proxy.Hello_("ioc")
+			assert.Nil(t, err)
+			assert.Equal(t, "hello ioc", result)
+			assert.Equal(t, []string{"before record", "attempts 1", "after record hello ioc"}, records)
+		})
+
+		t.Run("test around interceptor rewrites params", func(t *testing.T) {
+			records = records[:0]
+			result, err := proxy.Hello_("upper-ioc")
+			assert.Nil(t, err)
+			assert.Equal(t, "hello IOC", result)
+			assert.Equal(t, []string{"before record", "attempts 1", "after record hello IOC"}, records)
+		})
+
+		t.Run("test around interceptor calls next twice", func(t *testing.T) {
+			records = records[:0]
+			raw.calls = raw.calls[:0]
+			result, err := proxy.Hello_("twice")
+			assert.Nil(t, err)
+			assert.Equal(t, "hello twice", result)
+			assert.Equal(t, []string{"before record", "attempts 2", "after record hello twice"}, records)
+			assert.Equal(t, 2, len(raw.calls))
+		})
+
+		t.Run("test around interceptor skips invocation", func(t *testing.T) {
+			records = records[:0]
+			raw.calls = raw.calls[:0]
+			result, err := proxy.Hello_("skip")
+			assert.Equal(t, "skipped", err.Error())
+			assert.Equal(t, "", result)
+			assert.Equal(t, []string{"before record", "skip", "after record "}, records)
+			assert.Equal(t, 0, len(raw.calls))
+		})
+
+		t.Run("test around interceptor replaces return values", func(t *testing.T) {
+			records = records[:0]
+			result, err := proxy.Hello_("replace")
+			assert.Equal(t, "replaced", err.Error())
+			assert.Equal(t, "replaced", result)
+			assert.Equal(t, []string{"before record", "attempts 1", "after record replaced"}, records)
+		})
+
+		t.Run("test around interceptor calls raw method", func(t *testing.T) {
+			records = records[:0]
+			result, err := proxy.Hello_("raw")
+			assert.Nil(t, err)
+			assert.Equal(t, "hello raw", result)
+			assert.Equal(t, []string{"before record", "raw sum 6 true", "raw not exist false", "attempts 1",
+				"after record hello raw"}, records)
+		})
+
+		t.Run("test variadic method not matched by pointcut", func(t *testing.T) {
+			records = records[:0]
+			assert.Equal(t, 6, proxy.Sum_(1, 2, 3))
+			assert.Equal(t, 1, proxy.Sum_(1))
+			assert.Equal(t, 4, len(records))
+		})
+	})
+}
+
+func TestInvocationContext_ReturnValuesWithError(t *testing.T) {
+	ctx := &InvocationContext{
+		MethodType: reflect.TypeOf((*proxyTestImpl_)(nil)).Elem().Field(0).Type,
+	}
+	returnValues := ctx.ReturnValuesWithError(errors.New("failed"))
+	assert.Equal(t, 2, len(returnValues))
+	assert.Equal(t, "", returnValues[0].String())
+	assert.Equal(t, "failed", returnValues[1].Interface().(error).Error())
+
+	returnValues = ctx.ReturnValuesWithError(nil)
+	assert.True(t, returnValues[1].IsNil())
+
+	assert.Nil(t, (&InvocationContext{}).ReturnValuesWithError(errors.New("failed")))
+}
+
+func TestInvocationContext_ReturnsError(t *testing.T) {
+	assert.True(t, (&InvocationContext{MethodType: reflect.TypeOf(func() (string, error) { return "", nil })}).ReturnsError())
+	assert.False(t, (&InvocationContext{MethodType: reflect.TypeOf(func() string { return "" })}).ReturnsError())
+	assert.False(t, (&InvocationContext{MethodType: reflect.TypeOf(func() {})}).ReturnsError())
+	assert.False(t, (&InvocationContext{}).ReturnsError())
+}
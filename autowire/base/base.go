@@ -21,6 +21,7 @@ import (
 	"github.com/alibaba/ioc-golang/logger"
 
 	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/config"
 )
 
 type FacadeAutowire interface {
@@ -92,6 +93,9 @@ func (a *AutowireBase) ParseParam(sdID string, fi *autowire.FieldInfo) (interfac
 		param, err := sd.ParamLoader.Load(sd, fi)
 		if err == nil {
 			return param, nil
+		} else if config.IsValidationError(err) {
+			// param is loaded but invalid, do not fall back
+			return nil, err
 		} else {
 			// log warning, given pl load failed, fall back to default
 			logger.Red("[Autowire Base] Load SD %s param with defined sd.ParamLoader error: %s\n"+
@@ -18,6 +18,7 @@ package autowire
 import (
 	"fmt"
 
+	"github.com/alibaba/ioc-golang/config"
 	"github.com/alibaba/ioc-golang/logger"
 )
 
@@ -33,6 +34,14 @@ func printAutowireRegisteredStructDescriptor() {
 func Load() error {
 	printAutowireRegisteredStructDescriptor()
 
+	if config.IsValidateMode() {
+		// validate all params at once, and report all failures together
+		if err := validateAllParams(); err != nil {
+			logger.Red("[Autowire] Validate params failed, %s", err)
+			return err
+		}
+	}
+
 	// autowire all struct that can be entrance
 	for _, aw := range GetAllWrapperAutowires() {
 		for sdID := range aw.GetAllStructDescriptors() {
@@ -17,13 +17,17 @@ package autowire
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/alibaba/ioc-golang/autowire/util"
+	"github.com/alibaba/ioc-golang/config"
 	"github.com/alibaba/ioc-golang/logger"
 )
 
 const (
 	defaultProfileKey = "_default"
+	profileSeparator  = ", "
 )
 
 // implementsMap a map from interface SDID to implement struct SDID
@@ -41,9 +45,13 @@ func registerImplements(sd *StructDescriptor) {
 	}
 }
 
+// registerImplementMapping registers implStructSDID of interfaceSDID under activeProfile, which is profile
+// expression like 'dev', 'dev|test', '!prod' or 'cloud & !local'
 func registerImplementMapping(interfaceSDID, activeProfile, implStructSDID string) error {
 	if activeProfile == "" {
 		activeProfile = defaultProfileKey
+	} else if _, err := config.ParseProfileExpression(activeProfile); err != nil {
+		return fmt.Errorf("[Autowire Implement] Invalid active profile of %s, error = %s", implStructSDID, err)
 	}
 
 	// 1. assure interfaceSDID map exists
@@ -64,7 +72,12 @@ func registerImplementMapping(interfaceSDID, activeProfile, implStructSDID strin
 
 // GetBestImplementMapping get best impl struct sdids slice and profile of given interfaceSDID and activitedOrderedProfiles
 // if there isn't any matched implementation, even "_default" impl is not found, an error occurs
-// return values are bestMatchesStructImplSDIDs, bestMatchProfile, error
+// implementations whose profile expression matches activitedOrderedProfiles are ranked by specificity:
+// 1. expression requiring more active profiles wins, like 'dev & cloud' > 'dev'
+// 2. expression requiring later active profile wins, like 'pro' > 'dev' if activitedOrderedProfiles is [dev, pro]
+// 3. expression excluding more profiles wins, like 'dev & !local' > 'dev', '!prod' > "_default"
+// if multiple implements are equally specific, an error reporting the ambiguity occurs, instead of selecting any of them
+// return values are bestMatchesStructImplSDIDs with the only best implement, bestMatchProfile, error
 func GetBestImplementMapping(interfaceSDID string, activitedOrderedProfiles []string) ([]string, string, error) {
 	interfaceImplsMap, ok := implementsMap[interfaceSDID]
 	if !ok {
@@ -73,44 +86,81 @@ func GetBestImplementMapping(interfaceSDID string, activitedOrderedProfiles []st
 		return nil, "", err
 	}
 
-	// validate default fallback
-	if activitedOrderedProfiles == nil {
-		activitedOrderedProfiles = make([]string, 0)
-	}
-	activitedOrderedProfiles = append([]string{defaultProfileKey}, activitedOrderedProfiles...)
-	for k, v := range activitedOrderedProfiles {
-		if v == "" {
-			activitedOrderedProfiles[k] = defaultProfileKey
-		}
-	}
-
 	// get with best profile
-	bestMatchesStructImplSDIDsMap := make(map[string]struct{}, 0)
-	bestMatchProfile := ""
-	for i := len(activitedOrderedProfiles) - 1; i >= 0; i-- {
-		currentProfile := activitedOrderedProfiles[i]
-		bestMatchesStructImplSDIDsMap, ok = interfaceImplsMap[currentProfile]
-		if !ok || len(bestMatchesStructImplSDIDsMap) == 0 {
-			logger.Blue("[Autowire Implement] Interface %s implements with profile %s not found", interfaceSDID, currentProfile)
+	bestMatchProfiles := make([]string, 0)
+	var bestSpecificity config.ProfileSpecificity
+	for profile, implSDIDs := range interfaceImplsMap {
+		if len(implSDIDs) == 0 {
+			continue
+		}
+		specificity, matched := getProfileSpecificity(profile, activitedOrderedProfiles)
+		if !matched {
+			logger.Blue("[Autowire Implement] Interface %s implements with profile %s not match activited profiles %+v", interfaceSDID, profile, activitedOrderedProfiles)
 			continue
 		}
-		logger.Blue("[Autowire Implement] Interface %s implements SDID %s with profile %s bast matches activited profiles, select it(them)", interfaceSDID, bestMatchesStructImplSDIDsMap, currentProfile)
-		bestMatchProfile = currentProfile
-		break
+		if len(bestMatchProfiles) == 0 || specificity.Compare(bestSpecificity) > 0 {
+			bestMatchProfiles = []string{profile}
+			bestSpecificity = specificity
+		} else if specificity.Compare(bestSpecificity) == 0 {
+			bestMatchProfiles = append(bestMatchProfiles, profile)
+		}
 	}
-	if bestMatchProfile == "" {
+	if len(bestMatchProfiles) == 0 {
 		allImplementedProfiles := make([]string, 0)
 		for k := range interfaceImplsMap {
 			allImplementedProfiles = append(allImplementedProfiles, k)
 		}
+		sort.Strings(allImplementedProfiles)
 		err := fmt.Errorf("[Autowire Implement] Interface %s has implemented profile %+v, but activited profiles %+v doesn't match any",
 			interfaceSDID, allImplementedProfiles, activitedOrderedProfiles)
 		logger.Red(err.Error())
 		return nil, "", err
 	}
+	sort.Strings(bestMatchProfiles)
 	bestMatchesStructImplSDIDs := make([]string, 0)
-	for k := range bestMatchesStructImplSDIDsMap {
-		bestMatchesStructImplSDIDs = append(bestMatchesStructImplSDIDs, k)
+	for _, profile := range bestMatchProfiles {
+		for k := range interfaceImplsMap[profile] {
+			bestMatchesStructImplSDIDs = append(bestMatchesStructImplSDIDs, k)
+		}
+	}
+	sort.Strings(bestMatchesStructImplSDIDs)
+	bestMatchProfile := strings.Join(bestMatchProfiles, profileSeparator)
+	if len(bestMatchesStructImplSDIDs) > 1 {
+		err := &AmbiguousImplementsError{
+			InterfaceSDID:  interfaceSDID,
+			ImplSDIDs:      bestMatchesStructImplSDIDs,
+			Profile:        bestMatchProfile,
+			ActiveProfiles: activitedOrderedProfiles,
+		}
+		logger.Red(err.Error())
+		return nil, "", err
 	}
+	logger.Blue("[Autowire Implement] Interface %s implements SDID %+v with profile %s bast matches activited profiles, select it", interfaceSDID, bestMatchesStructImplSDIDs, bestMatchProfile)
 	return bestMatchesStructImplSDIDs, bestMatchProfile, nil
 }
+
+// AmbiguousImplementsError is returned by GetBestImplementMapping if multiple implements are equally specific
+type AmbiguousImplementsError struct {
+	InterfaceSDID  string
+	ImplSDIDs      []string
+	Profile        string
+	ActiveProfiles []string
+}
+
+func (e *AmbiguousImplementsError) Error() string {
+	return fmt.Sprintf("[Autowire Implement] Interface %s implements SDID %+v with profile %s are equally specific for activited profiles %+v, "+
+		"please specify one by tag value or make their active profiles different", e.InterfaceSDID, e.ImplSDIDs, e.Profile, e.ActiveProfiles)
+}
+
+// getProfileSpecificity returns specificity of profile expression, "_default" matches any activited profiles with
+// the lowest specificity
+func getProfileSpecificity(profile string, activitedOrderedProfiles []string) (config.ProfileSpecificity, bool) {
+	if profile == defaultProfileKey {
+		return config.ProfileSpecificity{LastIndex: -1}, true
+	}
+	profileExpression, err := config.ParseProfileExpression(profile)
+	if err != nil {
+		return config.ProfileSpecificity{}, false
+	}
+	return profileExpression.Specificity(activitedOrderedProfiles)
+}
@@ -0,0 +1,141 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package autowire
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetBestImplementMapping(t *testing.T) {
+	const interfaceSDID = "github.com/alibaba/ioc-golang/autowire.testProfileService"
+	for profile, implSDID := range map[string]string{
+		"":               "defaultImpl",
+		"!prod":          "notProdImpl",
+		"dev|test":       "devOrTestImpl",
+		"dev":            "devImpl",
+		"pro":            "proImpl",
+		"cloud & !local": "cloudImpl",
+		"cloud & dev":    "cloudDevImpl",
+		"(cloud & dev)":  "anotherCloudDevImpl",
+	} {
+		assert.Nil(t, registerImplementMapping(interfaceSDID, profile, implSDID))
+	}
+
+	tests := []struct {
+		name           string
+		activeProfiles []string
+		want           []string
+		wantProfile    string
+	}{
+		{
+			name: "test get default implements",
+			// prod excluded by !prod
+			activeProfiles: []string{"prod"},
+			want:           []string{"defaultImpl"},
+			wantProfile:    defaultProfileKey,
+		},
+		{
+			name:           "test get negation implements",
+			activeProfiles: nil,
+			want:           []string{"notProdImpl"},
+			wantProfile:    "!prod",
+		},
+		{
+			name:           "test get or implements",
+			activeProfiles: []string{"test"},
+			want:           []string{"devOrTestImpl"},
+			wantProfile:    "dev|test",
+		},
+		{
+			name:           "test get later profile implements",
+			activeProfiles: []string{"pro", "test"},
+			want:           []string{"devOrTestImpl"},
+			wantProfile:    "dev|test",
+		},
+		{
+			name:           "test get more negations implements",
+			activeProfiles: []string{"cloud"},
+			want:           []string{"cloudImpl"},
+			wantProfile:    "cloud & !local",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotProfile, err := GetBestImplementMapping(interfaceSDID, tt.activeProfiles)
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantProfile, gotProfile)
+		})
+	}
+
+	t.Run("test get ambiguous implements", func(t *testing.T) {
+		for activeProfiles, wantErr := range map[string]*AmbiguousImplementsError{
+			"dev": {
+				InterfaceSDID:  interfaceSDID,
+				ImplSDIDs:      []string{"devImpl", "devOrTestImpl"},
+				Profile:        "dev, dev|test",
+				ActiveProfiles: []string{"dev"},
+			},
+			"dev,cloud": {
+				InterfaceSDID:  interfaceSDID,
+				ImplSDIDs:      []string{"anotherCloudDevImpl", "cloudDevImpl"},
+				Profile:        "(cloud & dev), cloud & dev",
+				ActiveProfiles: []string{"dev", "cloud"},
+			},
+		} {
+			got, _, err := GetBestImplementMapping(interfaceSDID, strings.Split(activeProfiles, ","))
+			assert.Nil(t, got)
+			assert.Equal(t, wantErr, err)
+		}
+
+		const sameProfileInterfaceSDID = "github.com/alibaba/ioc-golang/autowire.testSameProfileService"
+		assert.Nil(t, registerImplementMapping(sameProfileInterfaceSDID, "", "impl1"))
+		assert.Nil(t, registerImplementMapping(sameProfileInterfaceSDID, "", "impl2"))
+		_, _, err := GetBestImplementMapping(sameProfileInterfaceSDID, nil)
+		assert.Equal(t, []string{"impl1", "impl2"}, err.(*AmbiguousImplementsError).ImplSDIDs)
+	})
+
+	t.Run("test get not matched implements", func(t *testing.T) {
+		const notMatchedInterfaceSDID = "github.com/alibaba/ioc-golang/autowire.testNotMatchedService"
+		assert.Nil(t, registerImplementMapping(notMatchedInterfaceSDID, "dev", "devImpl"))
+		_, _, err := GetBestImplementMapping(notMatchedInterfaceSDID, []string{"prod"})
+		assert.NotNil(t, err)
+		_, _, err = GetBestImplementMapping("github.com/alibaba/ioc-golang/autowire.notFound", nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("test register invalid profile expression", func(t *testing.T) {
+		assert.NotNil(t, registerImplementMapping(interfaceSDID, "dev &", "invalidImpl"))
+	})
+}
+
+func TestParseCommonActiveProfileMetadataFromSDMetadata(t *testing.T) {
+	newMetadata := func(activeProfile interface{}) Metadata {
+		return Metadata{
+			MetadataKey: map[string]interface{}{
+				CommonMetadataKey: map[string]interface{}{
+					CommonActiveProfileMetadataKey: activeProfile,
+				},
+			},
+		}
+	}
+	assert.Equal(t, "dev|test", parseCommonActiveProfileMetadataFromSDMetadata(newMetadata("dev|test")))
+	assert.Equal(t, "(dev) | (cloud & !local)", parseCommonActiveProfileMetadataFromSDMetadata(newMetadata([]interface{}{"dev", "cloud & !local"})))
+	assert.Equal(t, "", parseCommonActiveProfileMetadataFromSDMetadata(nil))
+}
@@ -15,6 +15,11 @@
 
 package autowire
 
+import (
+	"fmt"
+	"strings"
+)
+
 // autowire metadata key
 
 const MetadataKey = "autowire"
@@ -39,6 +44,7 @@ const CommonMetadataKey = "common"
 const CommonImplementsMetadataKey = "implements"
 const CommonActiveProfileMetadataKey = "activeProfile"
 const CommonLoadAtOnceMetadataKey = "loadAtOnce"
+const CommonRefreshScopeMetadataKey = "refreshScope"
 
 func parseCommonImplementsMetadataFromSDMetadata(metadata Metadata) []interface{} {
 	autowireMetadata := ParseAutowireMetadataFromSDMetadata(metadata)
@@ -65,11 +71,30 @@ func parseCommonActiveProfileMetadataFromSDMetadata(metadata Metadata) string {
 	if !ok {
 		return ""
 	}
-	result, ok := autowireCommonMetadata[CommonActiveProfileMetadataKey].(string)
-	if !ok {
-		return ""
+	switch result := autowireCommonMetadata[CommonActiveProfileMetadataKey].(type) {
+	case string:
+		return result
+	case []interface{}:
+		// multiple profile expressions, any of them matches
+		profiles := make([]string, 0, len(result))
+		for _, profile := range result {
+			profiles = append(profiles, fmt.Sprintf("%v", profile))
+		}
+		return JoinActiveProfiles(profiles)
 	}
-	return result
+	return ""
+}
+
+// JoinActiveProfiles joins multiple profile expressions to one expression that matches if any of them matches
+func JoinActiveProfiles(profiles []string) string {
+	if len(profiles) == 1 {
+		return profiles[0]
+	}
+	wrappedProfiles := make([]string, 0, len(profiles))
+	for _, profile := range profiles {
+		wrappedProfiles = append(wrappedProfiles, "("+profile+")")
+	}
+	return strings.Join(wrappedProfiles, " | ")
 }
 
 func parseCommonLoadAtOnceMetadataFromSDMetadata(metadata Metadata) bool {
@@ -87,3 +112,19 @@ func parseCommonLoadAtOnceMetadataFromSDMetadata(metadata Metadata) bool {
 	}
 	return result
 }
+
+func parseCommonRefreshScopeMetadataFromSDMetadata(metadata Metadata) bool {
+	autowireMetadata := ParseAutowireMetadataFromSDMetadata(metadata)
+	if autowireMetadata == nil {
+		return false
+	}
+	autowireCommonMetadata, ok := autowireMetadata[CommonMetadataKey].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	result, ok := autowireCommonMetadata[CommonRefreshScopeMetadataKey].(bool)
+	if !ok {
+		return false
+	}
+	return result
+}
@@ -17,6 +17,7 @@ package param_loader
 
 import (
 	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/config"
 )
 
 type defaultParamLoader struct {
@@ -45,16 +46,20 @@ Load try to load config from 3 types: ordered from harsh to loose
 2. Try to use defaultTagParamLoader to load from config
 3. Try to use defaultConfigParamLoader to load from config pointed by tag
 
-It will return with error if both way are failed.
+It will return with error if both way are failed, or if loaded param failed on validation.
 ```
 */
 func (d *defaultParamLoader) Load(sd *autowire.StructDescriptor, fi *autowire.FieldInfo) (interface{}, error) {
 	if param, err := d.defaultTagPointToParamLoader.Load(sd, fi); err == nil {
 		return param, nil
+	} else if config.IsValidationError(err) {
+		return nil, err
 	}
 	// todo log warning
 	if param, err := d.defaultTagParamLoader.Load(sd, fi); err == nil {
 		return param, nil
+	} else if config.IsValidationError(err) {
+		return nil, err
 	}
 	// todo log warning
 
@@ -92,5 +92,8 @@ func (p *defaultConfig) Load(sd *autowire.StructDescriptor, fi *autowire.FieldIn
 	if err := config.LoadConfigByPrefix(prefix, param); err != nil {
 		return nil, err
 	}
+	if err := config.Validate(prefix, param); err != nil {
+		return nil, err
+	}
 	return param, nil
 }
@@ -17,6 +17,7 @@ package param_loader
 
 import (
 	"encoding/json"
+	"fmt"
 	"log"
 	"strings"
 
@@ -83,5 +84,8 @@ func (p *defaultTag) Load(sd *autowire.StructDescriptor, fi *autowire.FieldInfo)
 		log.Printf("error jsonun marshal %s\n", err)
 		return nil, err
 	}
+	if err := config.Validate(fmt.Sprintf("%s:\"%s\"", fi.TagKey, fi.TagValue), param); err != nil {
+		return nil, err
+	}
 	return param, nil
 }
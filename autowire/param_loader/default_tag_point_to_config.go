@@ -103,5 +103,8 @@ func (p *defaultTagPointToConfig) Load(sd *autowire.StructDescriptor, fi *autowi
 	if err := config.LoadConfigByPrefix(prefix, param); err != nil {
 		return nil, err
 	}
+	if err := config.Validate(prefix, param); err != nil {
+		return nil, err
+	}
 	return param, nil
 }
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package param_loader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/autowire/util"
+	"github.com/alibaba/ioc-golang/config"
+)
+
+type testImpl struct {
+}
+
+type testParam struct {
+	Address string `validate:"required,hostname_port"`
+}
+
+var testSD = &autowire.StructDescriptor{
+	Factory: func() interface{} {
+		return &testImpl{}
+	},
+	ParamFactory: func() interface{} {
+		return &testParam{}
+	},
+}
+
+func TestDefaultParamLoader_Load(t *testing.T) {
+	prefix := "autowire.normal.<" + util.GetSDIDByStructPtr(&testImpl{}) + ">"
+	assert.Nil(t, config.Load(
+		config.AddProperty(prefix+".param.address", "localhost:6379"),
+		config.AddProperty(prefix+".valid.param.address", "localhost:6380"),
+		config.AddProperty(prefix+".invalid.param.address", "localhost"),
+	))
+
+	t.Run("test load valid params", func(t *testing.T) {
+		for tagValue, address := range map[string]string{
+			"Impl":                         "localhost:6379",
+			"Impl,valid":                   "localhost:6380",
+			"Impl,address=localhost:6381":  "localhost:6381",
+			"Impl,not-configured-instance": "localhost:6379",
+		} {
+			param, err := GetDefaultParamLoader().Load(testSD, &autowire.FieldInfo{
+				TagKey:   "normal",
+				TagValue: tagValue,
+			})
+			assert.Nil(t, err, tagValue)
+			assert.Equal(t, &testParam{Address: address}, param, tagValue)
+		}
+	})
+
+	t.Run("test return validation error without falling back", func(t *testing.T) {
+		for tagValue, path := range map[string]string{
+			"Impl,invalid":           prefix + ".invalid.param.address",
+			"Impl,address=localhost": `normal:"Impl,address=localhost".address`,
+		} {
+			_, err := GetDefaultParamLoader().Load(testSD, &autowire.FieldInfo{
+				TagKey:   "normal",
+				TagValue: tagValue,
+			})
+			assert.True(t, config.IsValidationError(err), tagValue)
+			assert.Equal(t, path, err.(config.ValidationErrors)[0].Path, tagValue)
+		}
+	})
+
+	t.Run("test return validation error of default config", func(t *testing.T) {
+		assert.Nil(t, config.Load(
+			config.AddProperty(prefix+".param.address", ""),
+		))
+		_, err := GetDefaultParamLoader().Load(testSD, &autowire.FieldInfo{
+			TagKey:   "normal",
+			TagValue: "Impl",
+		})
+		assert.True(t, config.IsValidationError(err))
+		assert.Equal(t, prefix+".param.address", err.(config.ValidationErrors)[0].Path)
+		assert.Equal(t, "required", err.(config.ValidationErrors)[0].Rule)
+	})
+}
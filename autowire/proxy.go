@@ -48,3 +48,23 @@ func GetProxyImplFunction() func(interface{}, interface{}, string) error {
 	}
 	return pif
 }
+
+// replaceable proxy function
+
+var rpf func(interface{}) (interface{}, func(interface{}) <-chan struct{})
+
+// RegisterReplaceableProxyFunction registers function that wraps raw ptr with proxy like proxy function, and also
+// returns function to replace raw instance behind the proxy, whose returned channel is closed when all calls to the
+// old instance finish
+func RegisterReplaceableProxyFunction(f func(interface{}) (interface{}, func(interface{}) <-chan struct{})) {
+	rpf = f
+}
+
+func GetReplaceableProxyFunction() func(interface{}) (interface{}, func(interface{}) <-chan struct{}) {
+	if rpf == nil {
+		return func(i interface{}) (interface{}, func(interface{}) <-chan struct{}) {
+			return GetProxyFunction()(i), nil
+		}
+	}
+	return rpf
+}
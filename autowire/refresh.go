@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package autowire
+
+import (
+	"github.com/alibaba/ioc-golang/config"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+/*
+watchRefreshScopeIfNecessary listens to param config of refresh scoped singleton marked by
+'+ioc:autowire:refreshScope', like 'autowire.singleton.<sdid>.param', the singleton is re-created when any key
+under the prefix changes.
+*/
+func (w *WrapperAutowireImpl) watchRefreshScopeIfNecessary(sdID string) {
+	sd := w.Autowire.GetAllStructDescriptors()[sdID]
+	if sd == nil || !parseCommonRefreshScopeMetadataFromSDMetadata(sd.Metadata) {
+		return
+	}
+	w.refreshScopeWatchedLock.Lock()
+	defer w.refreshScopeWatchedLock.Unlock()
+	if w.refreshScopeWatched[sdID] {
+		return
+	}
+	w.refreshScopeWatched[sdID] = true
+	prefix := getSDConfigPrefix(w.Autowire.TagKey(), sdID, sd) + config.YamlConfigSeparator + paramConfigKey
+	logger.Blue("[Wrapper Autowire] Refresh scoped struct %s is watching config %s", sdID, prefix)
+	config.AddChangeListener(prefix, func(events []*config.ChangeEvent) {
+		logger.Blue("[Wrapper Autowire] Param config of refresh scoped struct %s changed, refreshing", sdID)
+		if err := w.refresh(sdID); err != nil {
+			logger.Red("[Wrapper Autowire] Refresh struct %s failed, keep using the old one, error = %s", sdID, err)
+		}
+	})
+}
+
+/*
+refresh re-runs Factory, ParamLoader and ConstructFunc of singleton with current config, and swaps the instance behind
+cached proxy, so that all injected proxies call the new one. DestroyFunc is then called with the old instance after
+calls in flight finish. The old instance is kept if any step fails.
+*/
+func (w *WrapperAutowireImpl) refresh(sdID string) error {
+	w.refreshLock.Lock()
+	defer w.refreshLock.Unlock()
+	param, err := w.ParseParam(sdID, &FieldInfo{TagKey: w.Autowire.TagKey()})
+	if err != nil {
+		return err
+	}
+	newRawPtr, err := w.ImplWithParam(sdID, param, false, true)
+	if err != nil {
+		return err
+	}
+
+	w.singletonImpledMapLock.Lock()
+	singletonCache, ok := w.singletonImpledMap[sdID]
+	if !ok {
+		w.singletonImpledMapLock.Unlock()
+		return nil
+	}
+	oldRawPtr := singletonCache.RawPtr
+	var drained <-chan struct{}
+	if singletonCache.replaceProxyTarget != nil {
+		drained = singletonCache.replaceProxyTarget(newRawPtr)
+	} else {
+		logger.Blue("[Wrapper Autowire] Refresh scoped struct %s is injected without proxy, only new injection uses the new instance", sdID)
+	}
+	singletonCache.RawPtr = newRawPtr
+	w.singletonImpledMapLock.Unlock()
+
+	if sd := w.Autowire.GetAllStructDescriptors()[sdID]; sd != nil && sd.DestroyFunc != nil {
+		if drained == nil {
+			sd.DestroyFunc(oldRawPtr)
+		} else {
+			go func() {
+				<-drained
+				sd.DestroyFunc(oldRawPtr)
+			}()
+		}
+	}
+	logger.Blue("[Wrapper Autowire] Refresh struct %s success", sdID)
+	return nil
+}
@@ -16,10 +16,10 @@
 package sdid_parser
 
 import (
+	"errors"
 	"strings"
 
 	"github.com/alibaba/ioc-golang/config"
-	"github.com/alibaba/ioc-golang/logger"
 
 	"github.com/alibaba/ioc-golang/autowire"
 	"github.com/alibaba/ioc-golang/autowire/util"
@@ -48,13 +48,14 @@ func (p *defaultSDIDParser) Parse(fi *autowire.FieldInfo) (string, error) {
 		injectStructName = strings.TrimSuffix(fi.FieldType, "IOCInterface")
 	} else if !util.IsPointerField(fi.FieldReflectType) {
 		// is custom interface field, try to get best implements
-		if bestMatchSDIDs, matchProfile, err := autowire.GetBestImplementMapping(fi.FieldType, config.GetActiveProfiles()); err == nil {
-			// is interface field without valid sdid from tag value, without 'IOCInterface' suffix
-			// load injectStructName from implements annotation mapping
+		// is interface field without valid sdid from tag value, without 'IOCInterface' suffix
+		// load injectStructName from implements annotation mapping
+		bestMatchSDIDs, _, err := autowire.GetBestImplementMapping(fi.FieldType, config.GetActiveProfiles())
+		ambiguousErr := &autowire.AmbiguousImplementsError{}
+		if errors.As(err, &ambiguousErr) {
+			return "", err
+		} else if err == nil {
 			injectStructName = bestMatchSDIDs[0]
-			if len(bestMatchSDIDs) > 1 {
-				logger.Red("[Autowire Default SDIDParser] Field %s has multi impls [%+v] under profile %s, select first one.", fi.FieldType, bestMatchSDIDs, matchProfile)
-			}
 		}
 	}
 	return autowire.GetSDIDByAliasIfNecessary(injectStructName), nil
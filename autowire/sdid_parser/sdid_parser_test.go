@@ -16,11 +16,13 @@
 package sdid_parser
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/autowire/util"
 )
 
 func TestGetDefaultSDIDParser(t *testing.T) {
@@ -86,3 +88,63 @@ func Test_defaultSDIDParser_Parse(t *testing.T) {
 		})
 	}
 }
+
+type testService interface {
+	Get() string
+}
+
+type testAmbiguousService interface {
+	Get() string
+}
+
+type testServiceImpl struct {
+}
+
+func (t *testServiceImpl) Get() string {
+	return "testServiceImpl"
+}
+
+type testAnotherServiceImpl struct {
+}
+
+func (t *testAnotherServiceImpl) Get() string {
+	return "testAnotherServiceImpl"
+}
+
+func Test_defaultSDIDParser_ParseImplements(t *testing.T) {
+	registerImpl := func(factory func() interface{}, interfaces ...interface{}) {
+		autowire.RegisterStructDescriptor(&autowire.StructDescriptor{
+			Factory: factory,
+			Metadata: map[string]interface{}{
+				"autowire": map[string]interface{}{
+					"common": map[string]interface{}{
+						"implements": interfaces,
+					},
+				},
+			},
+		})
+	}
+	registerImpl(func() interface{} {
+		return &testServiceImpl{}
+	}, new(testService), new(testAmbiguousService))
+	registerImpl(func() interface{} {
+		return &testAnotherServiceImpl{}
+	}, new(testAmbiguousService))
+
+	p := &defaultSDIDParser{}
+	sdid, err := p.Parse(&autowire.FieldInfo{
+		FieldType:        util.GetSDIDByStructPtr(new(testService)),
+		FieldReflectType: reflect.TypeOf((*testService)(nil)).Elem(),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, util.GetSDIDByStructPtr(&testServiceImpl{}), sdid)
+
+	_, err = p.Parse(&autowire.FieldInfo{
+		FieldType:        util.GetSDIDByStructPtr(new(testAmbiguousService)),
+		FieldReflectType: reflect.TypeOf((*testAmbiguousService)(nil)).Elem(),
+	})
+	ambiguousErr := &autowire.AmbiguousImplementsError{}
+	assert.ErrorAs(t, err, &ambiguousErr)
+	assert.Equal(t, []string{util.GetSDIDByStructPtr(&testAnotherServiceImpl{}), util.GetSDIDByStructPtr(&testServiceImpl{})},
+		ambiguousErr.ImplSDIDs)
+}
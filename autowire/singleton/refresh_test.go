@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package singleton_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/autowire/normal"
+	"github.com/alibaba/ioc-golang/autowire/singleton"
+	"github.com/alibaba/ioc-golang/autowire/util"
+	"github.com/alibaba/ioc-golang/config"
+)
+
+type refreshParam struct {
+	Address string `yaml:"address"`
+}
+
+type refreshImpl struct {
+	address string
+}
+
+func (r *refreshImpl) GetAddress() string {
+	return r.address
+}
+
+// GetAddressAfter notifies started and returns address after released
+func (r *refreshImpl) GetAddressAfter(started chan<- struct{}, released <-chan struct{}) string {
+	close(started)
+	<-released
+	return r.address
+}
+
+// refreshImpl_ is proxy struct of refreshImpl
+type refreshImpl_ struct {
+	GetAddress_      func() string
+	GetAddressAfter_ func(started chan<- struct{}, released <-chan struct{}) string
+}
+
+func (r *refreshImpl_) GetAddress() string {
+	return r.GetAddress_()
+}
+
+func (r *refreshImpl_) GetAddressAfter(started chan<- struct{}, released <-chan struct{}) string {
+	return r.GetAddressAfter_(started, released)
+}
+
+type refreshImplIOCInterface interface {
+	GetAddress() string
+	GetAddressAfter(started chan<- struct{}, released <-chan struct{}) string
+}
+
+type refreshHolder struct {
+	Impl refreshImplIOCInterface `singleton:""`
+}
+
+type refreshRemoteSource struct {
+	contents string
+	onChange func(document *config.RemoteDocument)
+}
+
+func (r *refreshRemoteSource) Load(bootstrap config.Config) ([]*config.RemoteDocument, error) {
+	return []*config.RemoteDocument{{Name: "mock:refresh.yaml", Contents: []byte(r.contents)}}, nil
+}
+
+func (r *refreshRemoteSource) Watch(onChange func(document *config.RemoteDocument)) error {
+	r.onChange = onChange
+	return nil
+}
+
+func (r *refreshRemoteSource) publish(contents string) {
+	r.contents = contents
+	r.onChange(&config.RemoteDocument{Name: "mock:refresh.yaml", Contents: []byte(contents)})
+}
+
+func TestRefreshScope(t *testing.T) {
+	sdid := util.GetSDIDByStructPtr(&refreshImpl{})
+	normal.RegisterStructDescriptor(&autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &refreshImpl_{}
+		},
+	})
+	normal.RegisterStructDescriptor(&autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &refreshHolder{}
+		},
+	})
+	destroyed := make(chan string, 2)
+	singleton.RegisterStructDescriptor(&autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &refreshImpl{}
+		},
+		ParamFactory: func() interface{} {
+			return &refreshParam{}
+		},
+		ConstructFunc: func(impl interface{}, param interface{}) (interface{}, error) {
+			impl.(*refreshImpl).address = param.(*refreshParam).Address
+			return impl, nil
+		},
+		DestroyFunc: func(impl interface{}) {
+			destroyed <- impl.(*refreshImpl).address
+		},
+		Metadata: map[string]interface{}{
+			"autowire": map[string]interface{}{
+				"common": map[string]interface{}{
+					"refreshScope": true,
+				},
+			},
+		},
+	})
+
+	source := &refreshRemoteSource{
+		contents: "autowire:\n  singleton:\n    " + sdid + ":\n      param:\n        address: localhost:6379\n",
+	}
+	assert.Nil(t, config.Load(config.WithRemoteSource(source)))
+	holder, err := normal.GetImpl(util.GetSDIDByStructPtr(&refreshHolder{}), nil)
+	assert.Nil(t, err)
+	proxy := holder.(*refreshHolder).Impl
+	_, ok := proxy.(*refreshImpl_)
+	assert.True(t, ok)
+	assert.Equal(t, "localhost:6379", proxy.GetAddress())
+
+	t.Run("test refresh when param changed", func(t *testing.T) {
+		// old instance is destroyed after in-flight call finishes
+		started, released := make(chan struct{}), make(chan struct{})
+		inFlightAddress := make(chan string)
+		go func() {
+			inFlightAddress <- proxy.GetAddressAfter(started, released)
+		}()
+		<-started
+
+		source.publish("autowire:\n  singleton:\n    " + sdid + ":\n      param:\n        address: localhost:6380\n")
+		assert.Equal(t, "localhost:6380", proxy.GetAddress())
+		select {
+		case address := <-destroyed:
+			t.Fatalf("instance %s is destroyed with call in flight", address)
+		case <-time.After(time.Millisecond * 100):
+		}
+		close(released)
+		assert.Equal(t, "localhost:6379", <-inFlightAddress)
+		select {
+		case address := <-destroyed:
+			assert.Equal(t, "localhost:6379", address)
+		case <-time.After(time.Second):
+			t.Fatal("old instance is not destroyed")
+		}
+
+		newHolder, err := normal.GetImpl(util.GetSDIDByStructPtr(&refreshHolder{}), nil)
+		assert.Nil(t, err)
+		assert.True(t, proxy == newHolder.(*refreshHolder).Impl)
+		rawImpl, err := singleton.GetImpl(sdid, nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "localhost:6380", rawImpl.(*refreshImpl).GetAddress())
+	})
+
+	t.Run("test not refresh when other config changed", func(t *testing.T) {
+		source.publish("autowire:\n  singleton:\n    " + sdid + ":\n      param:\n        address: localhost:6380\n" +
+			"app:\n  name: refresh\n")
+		assert.Equal(t, "localhost:6380", proxy.GetAddress())
+		assert.Empty(t, destroyed)
+	})
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package autowire
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/alibaba/ioc-golang/config"
+)
+
+const paramConfigKey = "param"
+
+// validateAllParams loads params of all registered struct descriptors from config path like
+// 'autowire.normal.<sdid>.param' and 'autowire.normal.<sdid>.<instance-name>.param', and validates them,
+// all failures are aggregated to config.ValidationErrors.
+func validateAllParams() error {
+	validationErrors := make(config.ValidationErrors, 0)
+	for autowireType, aw := range GetAllWrapperAutowires() {
+		for sdID, sd := range aw.GetAllStructDescriptors() {
+			if sd.ParamFactory == nil {
+				continue
+			}
+			sdPrefix := getSDConfigPrefix(autowireType, sdID, sd)
+			sdConfig := make(map[string]interface{})
+			if err := config.LoadConfigByPrefix(sdPrefix, &sdConfig); err != nil {
+				// param of sd is not configured
+				continue
+			}
+			for key, subConfig := range sdConfig {
+				prefix := sdPrefix + config.YamlConfigSeparator + paramConfigKey
+				if key != paramConfigKey {
+					if subMap, ok := subConfig.(map[string]interface{}); !ok || subMap[paramConfigKey] == nil {
+						continue
+					}
+					prefix = sdPrefix + config.YamlConfigSeparator + key + config.YamlConfigSeparator + paramConfigKey
+				}
+				param := sd.ParamFactory()
+				if err := config.LoadConfigByPrefix(prefix, param); err != nil {
+					continue
+				}
+				if err := config.Validate(prefix, param); err != nil {
+					if errs, ok := err.(config.ValidationErrors); ok {
+						validationErrors = append(validationErrors, errs...)
+					}
+				}
+			}
+		}
+	}
+	if len(validationErrors) == 0 {
+		return nil
+	}
+	sort.Slice(validationErrors, func(i, j int) bool {
+		return validationErrors[i].Path < validationErrors[j].Path
+	})
+	return validationErrors
+}
+
+// getSDConfigPrefix returns config prefix of struct descriptor like 'autowire.normal.<sdid>', alias is used if set
+func getSDConfigPrefix(autowireType, sdID string, sd *StructDescriptor) string {
+	structConfigPathKey := sd.Alias
+	if structConfigPathKey == "" {
+		structConfigPathKey = sdID
+	}
+	return fmt.Sprintf("autowire%[1]s%[2]s%[1]s<%[3]s>", config.YamlConfigSeparator, autowireType, structConfigPathKey)
+}
@@ -0,0 +1,88 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package autowire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/autowire/util"
+	"github.com/alibaba/ioc-golang/config"
+)
+
+const validateTestAutowireType = "validate-test"
+
+type validateTestImpl struct {
+}
+
+type validateTestParam struct {
+	Address string `validate:"required,hostname_port"`
+}
+
+var validateTestSDID = util.GetSDIDByStructPtr(&validateTestImpl{})
+
+func TestValidateAllParams(t *testing.T) {
+	mockAutowire := NewMockAutowire(t)
+	mockAutowire.On("TagKey").Return(validateTestAutowireType)
+	mockAutowire.On("GetAllStructDescriptors").Return(map[string]*StructDescriptor{
+		validateTestSDID: {
+			Factory: func() interface{} {
+				return &validateTestImpl{}
+			},
+			ParamFactory: func() interface{} {
+				return &validateTestParam{}
+			},
+		},
+		util.GetSDIDByStructPtr(&MockImpl{}): {
+			Factory: func() interface{} {
+				return &MockImpl{}
+			},
+		},
+	})
+	RegisterAutowire(mockAutowire)
+	defer delete(wrapperAutowireMap, validateTestAutowireType)
+	sdPrefix := "autowire." + validateTestAutowireType + ".<" + validateTestSDID + ">"
+
+	t.Run("test aggregate failures of default and named params", func(t *testing.T) {
+		assert.Nil(t, config.Load(
+			config.AddProperty(sdPrefix+".param.address", "localhost"),
+			config.AddProperty(sdPrefix+".instance-b.param.address", ""),
+			config.AddProperty(sdPrefix+".instance-a.param.address", "localhost:6379"),
+			config.AddProperty(sdPrefix+".expand.address", "not-param"),
+		))
+		err := validateAllParams()
+		assert.True(t, config.IsValidationError(err))
+		validationErrors := err.(config.ValidationErrors)
+		assert.Equal(t, 2, len(validationErrors))
+		assert.Equal(t, sdPrefix+".instance-b.param.address", validationErrors[0].Path)
+		assert.Equal(t, "required", validationErrors[0].Rule)
+		assert.Equal(t, sdPrefix+".param.address", validationErrors[1].Path)
+		assert.Equal(t, "hostname_port", validationErrors[1].Rule)
+	})
+
+	t.Run("test all params valid", func(t *testing.T) {
+		assert.Nil(t, config.Load(
+			config.AddProperty(sdPrefix+".param.address", "localhost:6379"),
+		))
+		assert.Nil(t, validateAllParams())
+	})
+
+	t.Run("test param not configured", func(t *testing.T) {
+		assert.Nil(t, config.Load())
+		assert.Nil(t, validateAllParams())
+	})
+}
@@ -22,6 +22,7 @@ import (
 
 	perrors "github.com/pkg/errors"
 
+	"github.com/alibaba/ioc-golang/config"
 	"github.com/alibaba/ioc-golang/logger"
 
 	"github.com/alibaba/ioc-golang/autowire/util"
@@ -38,15 +39,18 @@ type WrapperAutowire interface {
 
 func getWrappedAutowire(autowire Autowire, allAutowires map[string]WrapperAutowire) WrapperAutowire {
 	return &WrapperAutowireImpl{
-		Autowire:           autowire,
-		allAutowires:       allAutowires,
-		singletonImpledMap: make(map[string]*SingletonCache),
+		Autowire:            autowire,
+		allAutowires:        allAutowires,
+		singletonImpledMap:  make(map[string]*SingletonCache),
+		refreshScopeWatched: make(map[string]bool),
 	}
 }
 
 type SingletonCache struct {
 	RawPtr   interface{}
 	ProxyPtr interface{}
+	// replaceProxyTarget replaces raw instance behind ProxyPtr, which is nil if proxy is not replaceable
+	replaceProxyTarget func(interface{}) <-chan struct{}
 }
 
 type WrapperAutowireImpl struct {
@@ -54,6 +58,12 @@ type WrapperAutowireImpl struct {
 	singletonImpledMap     map[string]*SingletonCache
 	singletonImpledMapLock sync.RWMutex
 	allAutowires           map[string]WrapperAutowire
+
+	// refreshScopeWatched records refresh scoped singletons that are watching param config
+	refreshScopeWatched     map[string]bool
+	refreshScopeWatchedLock sync.Mutex
+	// refreshLock serializes refreshing of singletons
+	refreshLock sync.Mutex
 }
 
 // ImplWithParam is used to get impled struct with param
@@ -97,12 +107,12 @@ func (w *WrapperAutowireImpl) ImplWithParam(sdID string, param interface{}, with
 	// 3. construct field
 	rawPtr, err = w.Autowire.Construct(sdID, rawPtr, param)
 	if err != nil {
-		errMsg := fmt.Sprintf("Construct struct %s failed with error = %s, param = %s", sdID, err.Error(), param)
+		errMsg := fmt.Sprintf("Construct struct %s failed with error = %s, param = %s", sdID, err.Error(), config.MaskDecryptedFields("", param))
 		logger.Red(errMsg)
 		return nil, fmt.Errorf(errMsg)
 	}
 	if rawPtr == nil {
-		errMsg := fmt.Sprintf("Construct struct %s failed, constructed ptr is nil, param = %s", sdID, param)
+		errMsg := fmt.Sprintf("Construct struct %s failed, constructed ptr is nil, param = %s", sdID, config.MaskDecryptedFields("", param))
 		logger.Red(errMsg)
 		return nil, fmt.Errorf(errMsg)
 	}
@@ -116,9 +126,10 @@ func (w *WrapperAutowireImpl) ImplWithParam(sdID string, param interface{}, with
 	// 4. try to wrap proxy
 	var finalPtr = rawPtr
 	var proxyPtr interface{}
+	var replaceProxyTarget func(interface{}) <-chan struct{}
 	if withProxy {
 		// if field is interface, try to inject proxy wrapped pointer
-		finalPtr = GetProxyFunction()(rawPtr)
+		finalPtr, replaceProxyTarget = GetReplaceableProxyFunction()(rawPtr)
 		proxyPtr = finalPtr
 	}
 
@@ -126,10 +137,12 @@ func (w *WrapperAutowireImpl) ImplWithParam(sdID string, param interface{}, with
 	if w.Autowire.IsSingleton() && !force {
 		w.singletonImpledMapLock.Lock()
 		w.singletonImpledMap[sdID] = &SingletonCache{
-			RawPtr:   rawPtr,
-			ProxyPtr: proxyPtr,
+			RawPtr:             rawPtr,
+			ProxyPtr:           proxyPtr,
+			replaceProxyTarget: replaceProxyTarget,
 		}
 		w.singletonImpledMapLock.Unlock()
+		w.watchRefreshScopeIfNecessary(sdID)
 	}
 	return finalPtr, nil
 }
@@ -138,7 +151,7 @@ func (w *WrapperAutowireImpl) ImplWithParam(sdID string, param interface{}, with
 func (w *WrapperAutowireImpl) ImplWithoutParam(sdID string, withProxy, force bool) (interface{}, error) {
 	param, err := w.ParseParam(sdID, nil)
 	if err != nil {
-		if w.Autowire.IsSingleton() {
+		if w.Autowire.IsSingleton() && !config.IsValidationError(err) {
 			// FIXME: ignore parse param error, because of singleton with empty param also try to find property from config file
 			logger.Blue("[Wrapper Autowire] Parse param from config file with sdid %s failed, error: %s, continue with nil param.", sdID, err)
 			return w.ImplWithParam(sdID, param, withProxy, force)
@@ -168,7 +181,7 @@ func (w *WrapperAutowireImpl) implWithField(fi *FieldInfo) (interface{}, error)
 	}
 	param, err := w.ParseParam(sdID, fi)
 	if err != nil {
-		if w.Autowire.IsSingleton() {
+		if w.Autowire.IsSingleton() && !config.IsValidationError(err) {
 			// ignore parse param error, because of singleton with empty param also try to find property from config file
 			return w.ImplWithParam(sdID, param, implWithProxy, false)
 		} else {
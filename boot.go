@@ -46,3 +46,10 @@ func Load(opts ...config.Option) error {
 	logger.Blue("[Boot] Start to load autowire")
 	return autowire.Load()
 }
+
+// Stop flushes data and releases resources of ioc-golang, like spans of OpenTelemetry tracer, which should be called
+// before app exits
+func Stop() {
+	logger.Blue("[Boot] Start to stop AOP")
+	aop.Stop()
+}
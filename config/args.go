@@ -0,0 +1,208 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+const (
+	ArgPropertyPrefix = "--ioc."
+	ArgProfileKey     = "--ioc-profile"
+
+	argValueSeparator = "="
+	argTerminator     = "--"
+
+	strTag   = "!!str"
+	intTag   = "!!int"
+	floatTag = "!!float"
+	boolTag  = "!!bool"
+	seqTag   = "!!seq"
+)
+
+// loadFromArgs parses command line flags like '--ioc.autowire.config.strValue=val' and '--ioc-profile=dev,share',
+// properties parsed from args are stored to ArgProperties as strings, and have the highest precedence. Flag values
+// are converted by type of the field they are loaded to, see resolveArgNodes.
+func (opts *Options) loadFromArgs() {
+	for i := 0; i < len(opts.Args); i++ {
+		arg := opts.Args[i]
+		if arg == argTerminator {
+			return
+		}
+		key, value, found := strings.Cut(arg, argValueSeparator)
+		if key != ArgProfileKey && !strings.HasPrefix(key, ArgPropertyPrefix) {
+			continue
+		}
+		if !found {
+			if key != ArgProfileKey {
+				// --ioc.autowire.enable, the following arg is positional and never taken as value
+				value = "true"
+			} else if i+1 < len(opts.Args) && !strings.HasPrefix(opts.Args[i+1], argTerminator) {
+				// --ioc-profile dev
+				i++
+				value = opts.Args[i]
+			}
+		}
+
+		if key == ArgProfileKey {
+			if !isBlankString(value) {
+				opts.ProfilesActive = strings.Split(value, EnvValueSeparator)
+			}
+			continue
+		}
+		propertyKey := strings.TrimPrefix(key, ArgPropertyPrefix)
+		if propertyKey == "" {
+			logger.Red("[Config] Invalid command line flag %s, property key is empty", arg)
+			continue
+		}
+		opts.ArgProperties[propertyKey] = value
+	}
+}
+
+// resolveArgNodes converts string scalars set by command line flags in node to type t of the target they are
+// loaded to, path is config path of node. Values loaded to string and interface{} are kept as they are, values
+// loaded to slice are split by ','.
+func resolveArgNodes(node *yaml.Node, t reflect.Type, path string) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i].Value
+			if valueType, ok := getYamlValueType(t, key); ok {
+				resolveArgNodes(node.Content[i+1], valueType, joinConfigPath(path, key))
+			}
+		}
+	case yaml.SequenceNode:
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for i, item := range node.Content {
+			resolveArgNodes(item, t.Elem(), path+"["+strconv.Itoa(i)+"]")
+		}
+	case yaml.ScalarNode:
+		if node.ShortTag() == strTag && GetOrigin(path) == OriginArgs {
+			resolveArgScalarNode(node, t)
+		}
+	}
+}
+
+// resolveArgScalarNode converts string scalar node to type t, node is left as string if its value is not valid
+// for t, so that decoding fails with type mismatch error
+func resolveArgScalarNode(node *yaml.Node, t reflect.Type) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		items := strings.Split(node.Value, EnvValueSeparator)
+		node.Kind, node.Tag, node.Style, node.Value = yaml.SequenceNode, seqTag, 0, ""
+		node.Content = make([]*yaml.Node, 0, len(items))
+		for _, item := range items {
+			itemNode := &yaml.Node{Kind: yaml.ScalarNode, Tag: strTag, Value: strings.TrimSpace(item)}
+			resolveArgScalarNode(itemNode, t.Elem())
+			node.Content = append(node.Content, itemNode)
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if intValue, err := strconv.ParseInt(node.Value, 10, 64); err == nil {
+			setScalarNode(node, intTag, strconv.FormatInt(intValue, 10))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if uintValue, err := strconv.ParseUint(node.Value, 10, 64); err == nil {
+			setScalarNode(node, intTag, strconv.FormatUint(uintValue, 10))
+		}
+	case reflect.Float32, reflect.Float64:
+		if floatValue, err := strconv.ParseFloat(node.Value, 64); err == nil && !math.IsInf(floatValue, 0) &&
+			!math.IsNaN(floatValue) {
+			setScalarNode(node, floatTag, strconv.FormatFloat(floatValue, 'g', -1, 64))
+		}
+	case reflect.Bool:
+		if boolValue, err := strconv.ParseBool(node.Value); err == nil {
+			setScalarNode(node, boolTag, strconv.FormatBool(boolValue))
+		}
+	}
+}
+
+func setScalarNode(node *yaml.Node, tag, value string) {
+	node.Tag, node.Style, node.Value = tag, 0, value
+}
+
+// getYamlValueType returns type of value of key in map or struct t, struct fields are named by yaml tag or lower
+// case field name, the same as yaml decoding
+func getYamlValueType(t reflect.Type, key string) (reflect.Type, bool) {
+	switch t.Kind() {
+	case reflect.Map:
+		return t.Elem(), true
+	case reflect.Struct:
+	default:
+		return nil, false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, inline, ok := getYamlFieldKey(field)
+		if !ok {
+			continue
+		}
+		if inline {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Ptr {
+				fieldType = fieldType.Elem()
+			}
+			if valueType, ok := getYamlValueType(fieldType, key); ok {
+				return valueType, true
+			}
+			continue
+		}
+		if name == key {
+			return field.Type, true
+		}
+	}
+	return nil, false
+}
+
+// getYamlFieldKey returns yaml key of struct field, which is its yaml tag or lowercased name, and if it's inlined.
+// false is returned if field is not decoded by yaml.
+func getYamlFieldKey(field reflect.StructField) (string, bool, bool) {
+	if field.PkgPath != "" && !field.Anonymous {
+		return "", false, false
+	}
+	tag := field.Tag.Get("yaml")
+	if tag == "-" {
+		return "", false, false
+	}
+	name, flags, _ := strings.Cut(tag, ",")
+	if name == "" {
+		name = strings.ToLower(field.Name)
+	}
+	return name, isInlineField(flags), true
+}
+
+func isInlineField(flags string) bool {
+	for _, flag := range strings.Split(flags, ",") {
+		if flag == "inline" {
+			return true
+		}
+	}
+	return false
+}
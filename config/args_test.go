@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestWithArgs(t *testing.T) {
+	assert.Nil(t, Load(
+		WithConfigName("ioc_golang"),
+		WithSearchPath("./test"),
+		WithArgs([]string{
+			"./app",
+			"--ioc-profile", "dev",
+			"--ioc.autowire.config.strValue=argVal",
+			"--ioc.autowire.config.intValue=456",
+			"--ioc.profilesActive.shared.boolValue=false",
+			"--ioc.autowire.config.sliceValue=a,b",
+			"--ioc.autowire.config.floatValue=1.5",
+			"--ioc.autowire.config.codeValue=007",
+			"--ioc.autowire.config.nanValue=nan",
+			"--ioc.autowire.config.enable",
+			"./positional",
+			"--other-flag=ignored",
+			"--",
+			"--ioc.autowire.config.ignored=true",
+		}),
+		AddProperty("autowire.config.strValue", "apiVal"),
+	))
+
+	assert.Equal(t, []string{"dev"}, GetActiveProfiles())
+
+	strValue := ""
+	assert.Nil(t, LoadConfigByPrefix("autowire.config.strValue", &strValue))
+	assert.Equal(t, "argVal", strValue)
+
+	intValue := 0
+	assert.Nil(t, LoadConfigByPrefix("autowire.config.intValue", &intValue))
+	assert.Equal(t, 456, intValue)
+
+	boolValue := true
+	assert.Nil(t, LoadConfigByPrefix("profilesActive.shared.boolValue", &boolValue))
+	assert.False(t, boolValue)
+
+	sliceValue := make([]string, 0)
+	assert.Nil(t, LoadConfigByPrefix("autowire.config.sliceValue", &sliceValue))
+	assert.Equal(t, []string{"a", "b"}, sliceValue)
+
+	floatValue := 0.0
+	assert.Nil(t, LoadConfigByPrefix("autowire.config.floatValue", &floatValue))
+	assert.Equal(t, 1.5, floatValue)
+
+	codeValue := ""
+	assert.Nil(t, LoadConfigByPrefix("autowire.config.codeValue", &codeValue))
+	assert.Equal(t, "007", codeValue)
+
+	nanValue := ""
+	assert.Nil(t, LoadConfigByPrefix("autowire.config.nanValue", &nanValue))
+	assert.Equal(t, "nan", nanValue)
+	floatValue = 0.0
+	assert.NotNil(t, LoadConfigByPrefix("autowire.config.nanValue", &floatValue))
+
+	// comma separated value is kept as string if target is not slice
+	strSliceValue := ""
+	assert.Nil(t, LoadConfigByPrefix("autowire.config.sliceValue", &strSliceValue))
+	assert.Equal(t, "a,b", strSliceValue)
+
+	configStruct := &struct {
+		IntValue   int      `yaml:"intValue"`
+		SliceValue []string `yaml:"sliceValue"`
+		StrValue   string   `yaml:"strValue"`
+		Enable     bool
+	}{}
+	assert.Nil(t, LoadConfigByPrefix("autowire.config", configStruct))
+	assert.Equal(t, 456, configStruct.IntValue)
+	assert.Equal(t, []string{"a", "b"}, configStruct.SliceValue)
+	assert.Equal(t, "argVal", configStruct.StrValue)
+	assert.True(t, configStruct.Enable)
+
+	enable := false
+	assert.Nil(t, LoadConfigByPrefix("autowire.config.enable", &enable))
+	assert.True(t, enable)
+
+	ignored := false
+	assert.NotNil(t, LoadConfigByPrefix("autowire.config.ignored", &ignored))
+}
+
+func TestResolveArgScalarNode(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		target interface{}
+		want   interface{}
+	}{
+		{name: "int", value: "123", target: new(int), want: 123},
+		{name: "leading zero int", value: "007", target: new(int), want: 7},
+		{name: "leading zero string", value: "007", target: new(string), want: "007"},
+		{name: "float", value: "1.5", target: new(float64), want: 1.5},
+		{name: "bool", value: "True", target: new(bool), want: true},
+		{name: "bool string", value: "true", target: new(string), want: "true"},
+		{name: "string", value: "localhost:6379", target: new(string), want: "localhost:6379"},
+		{name: "interface", value: "123", target: new(interface{}), want: "123"},
+		{name: "list", value: "1, 2", target: new([]int), want: []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := &yaml.Node{Kind: yaml.ScalarNode, Tag: strTag, Value: tt.value}
+			resolveArgScalarNode(node, reflect.TypeOf(tt.target))
+			assert.Nil(t, node.Decode(tt.target))
+			assert.Equal(t, tt.want, reflect.ValueOf(tt.target).Elem().Interface())
+		})
+	}
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, value := range []string{"inf", "nan", "1,2"} {
+			node := &yaml.Node{Kind: yaml.ScalarNode, Tag: strTag, Value: value}
+			resolveArgScalarNode(node, reflect.TypeOf(0.0))
+			assert.NotNil(t, node.Decode(new(float64)))
+		}
+	})
+}
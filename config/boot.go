@@ -16,9 +16,18 @@
 package config
 
 import (
+	"bytes"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
+
+	perrors "github.com/pkg/errors"
 
 	"github.com/alibaba/ioc-golang/logger"
 
@@ -38,13 +47,17 @@ const (
 	TypeEnvKey          = "IOC_GOLANG_CONFIG_TYPE"
 	NameEnvKey          = "IOC_GOLANG_CONFIG_NAME"
 	ActiveProfileEnvKey = "IOC_GOLANG_CONFIG_ACTIVE_PROFILE"
+	ListMergeEnvKey     = "IOC_GOLANG_CONFIG_LIST_MERGE"
 
 	YamlConfigSeparator = "."
 	EnvValueSeparator   = ","
 )
 
 var (
-	config               Config
+	// currentConfig stores effective Config, which is built completely before stored, so that readers never see a
+	// config being merged or parsed
+	currentConfig        atomic.Value
+	loadLock             sync.Mutex
 	activeProfile        = make([]string, 0)
 	supportedConfigTypes = []string{YmlExtension, YamlExtension}
 	DefaultSearchPath    = []string{".", "./config", "./configs"}
@@ -75,8 +88,34 @@ type Options struct {
 	ProfilesActive []string
 	// Depth of merging under multiple config files
 	MergeDepth uint8
+	// Strategy of merging lists under multiple config files, which can be overridden by key directive like 'brokers+'
+	//
+	// default: replace
+	ListMergeStrategy ListMergeStrategy
+	// Item key used by merge-by-key list merge strategy
+	//
+	// default: name
+	ListMergeKey string
 	// Properties set by API
 	Properties AnyMap
+	// Command line args, e.g. os.Args
+	//
+	// '--ioc.<key>=<value>' sets property, bare '--ioc.<key>' sets it to true, '--ioc-profile=dev' sets active profiles
+	Args []string
+	// Properties parsed from Args, which have the highest priority
+	ArgProperties AnyMap
+	// Validate all struct params before constructing, and aggregate all failures
+	ValidateMode bool
+	// Pattern of encrypted config value, the first sub match is base64 encoded cipher text
+	//
+	// default: ^ENC\((.*)\)$
+	EncryptedValuePattern string
+	// Decryptor of encrypted config value
+	//
+	// default: AES-GCM decryptor with key from env IOC_GOLANG_CONFIG_DECRYPT_KEY or IOC_GOLANG_CONFIG_DECRYPT_KEY_FILE
+	Decryptor Decryptor
+	// Remote sources of config, like config center, which are used with sources registered by RegisterRemoteSource
+	RemoteSources []RemoteSource
 }
 
 func (opts *Options) printLogs() {
@@ -88,6 +127,8 @@ func (opts *Options) loadFromEnv() {
 	opts.ConfigType = os.Getenv(TypeEnvKey)
 	opts.ConfigName = os.Getenv(NameEnvKey)
 	opts.ProfilesActive = loadSplitedStringsFromEnvWith(ActiveProfileEnvKey)
+	opts.ListMergeStrategy = ListMergeStrategy(strings.TrimSpace(os.Getenv(ListMergeEnvKey)))
+	opts.ValidateMode, _ = strconv.ParseBool(os.Getenv(ValidateModeEnvKey))
 }
 
 func (opts *Options) validate() {
@@ -103,12 +144,44 @@ func (opts *Options) validate() {
 	if opts.MergeDepth == 0 {
 		opts.MergeDepth = defaultMergeDepth
 	}
+	if isBlankString(string(opts.ListMergeStrategy)) {
+		opts.ListMergeStrategy = ListMergeReplace
+	}
+	if isBlankString(opts.ListMergeKey) {
+		opts.ListMergeKey = DefaultListMergeKey
+	}
+	if isBlankString(opts.EncryptedValuePattern) {
+		opts.EncryptedValuePattern = DefaultEncryptedValuePattern
+	}
+}
+
+func (opts *Options) getDecryptor() (Decryptor, error) {
+	if opts.Decryptor != nil {
+		return opts.Decryptor, nil
+	}
+	return newDefaultDecryptor()
 }
 
 // ----------------------------------------------------------------
 
 func SetConfig(yamlBytes []byte) error {
-	return yaml.Unmarshal(yamlBytes, &config)
+	loadLock.Lock()
+	defer loadLock.Unlock()
+	newConfig, _ := copyConfigValue(getConfig()).(Config)
+	if err := yaml.Unmarshal(yamlBytes, &newConfig); err != nil {
+		return err
+	}
+	setConfig(newConfig)
+	return nil
+}
+
+func getConfig() Config {
+	cfg, _ := currentConfig.Load().(Config)
+	return cfg
+}
+
+func setConfig(cfg Config) {
+	currentConfig.Store(cfg)
 }
 
 func Load(opts ...Option) error {
@@ -118,47 +191,116 @@ func Load(opts ...Option) error {
 		return nil
 	}
 
-	targetMap := make(Config)
-
 	options.printLogs()
+	if !options.ListMergeStrategy.isValid() {
+		err := perrors.Errorf("[Config] Invalid list merge strategy %s set by env %s or WithListMergeStrategy, supported strategies are %s, %s and %s",
+			options.ListMergeStrategy, ListMergeEnvKey, ListMergeReplace, ListMergeAppend, ListMergeByKey)
+		logger.Red(err.Error())
+		return err
+	}
+
+	loadLock.Lock()
+	defer loadLock.Unlock()
+	resetLoadingOrigins()
+
+	targetMap, err := loadConfigFiles(options)
+	if err != nil || targetMap == nil {
+		return err
+	}
+	remoteDocuments, err := loadRemoteDocuments(copyConfigValue(targetMap).(Config), options)
+	if err != nil {
+		return err
+	}
+	if targetMap, err = mergeRemoteDocuments(targetMap, remoteDocuments, options); err != nil {
+		return err
+	}
+	addProperties(targetMap, options.Properties, OriginAPI)
+	addProperties(targetMap, options.ArgProperties, OriginArgs)
+	if err := parseConfigIfNecessary(targetMap, options); err != nil {
+		return err
+	}
 
-	// set profile
+	// set profile and config
 	activeProfile = options.ProfilesActive
+	validateMode = options.ValidateMode
+	setConfig(targetMap)
+	commitLoadingOrigins()
+	return watchRemoteSources(remoteDocuments, options)
+}
 
+// loadConfigFiles loads and merges config files searched with options, nil is returned if any file failed to read
+func loadConfigFiles(options *Options) (Config, error) {
+	targetMap := make(Config)
 	configFiles := searchConfigFiles(options)
 
 	for _, cf := range configFiles {
+		// root of import chain is cleaned like imported files, so that import cycle back to it is detected
+		cf = filepath.Clean(cf)
 		logger.Blue("[Config] Loading config file %s", cf)
 		contents, err := ioutil.ReadFile(cf)
 		if err != nil {
 			logger.Red("[Config] Load ioc-golang config file failed. %v\n The load procedure is continue", err)
-			return nil
+			return nil, nil
 		}
 
+		if targetMap, err = mergeConfigDocuments(targetMap, contents, []string{cf}, options); err != nil {
+			logger.Red("[Config] Load config file %s failed, err: %v", cf, err)
+			return nil, err
+		}
+	}
+	return targetMap, nil
+}
+
+// mergeConfigDocuments merges all yaml documents separated by '---' in contents of importChain's last file into
+// targetMap in order, documents gated by ProfilesGateKey are merged only if the gate matches active profiles, and
+// files imported by ImportKey are merged before the importing document
+func mergeConfigDocuments(targetMap Config, contents []byte, importChain []string, options *Options) (Config, error) {
+	fileName := importChain[len(importChain)-1]
+	decoder := yaml.NewDecoder(bytes.NewReader(contents))
+	for {
+		document := &yaml.Node{}
+		if err := decoder.Decode(document); err != nil {
+			if err == io.EOF {
+				return targetMap, nil
+			}
+			return targetMap, err
+		}
 		var sub Config
-		err = yaml.Unmarshal(contents, &sub)
+		if err := document.Decode(&sub); err != nil {
+			return targetMap, err
+		}
+		gate, err := popProfilesGate(sub)
 		if err != nil {
-			logger.Red("[Config] yamlFile Unmarshal err: %v", err)
-			return err
+			return targetMap, perrors.Errorf("%s:%d %s", fileName, document.Line, err)
+		}
+		if gate != nil {
+			matched, err := MatchProfiles(gate, options.ProfilesActive)
+			if err != nil {
+				return targetMap, perrors.Errorf("%s:%d %s", fileName, document.Line, err)
+			}
+			if !matched {
+				logger.Blue("[Config] Skip document at %s:%d, profiles %v not match active profiles %v", fileName, document.Line, gate, options.ProfilesActive)
+				continue
+			}
+		}
+		if targetMap, err = mergeImports(targetMap, sub, importChain, options); err != nil {
+			return targetMap, err
 		}
-
 		if len(sub) > 0 {
-			targetMap = MergeMap(targetMap, sub)
+			targetMap = MergeMapWithOptions(targetMap, sub, MergeOptions{
+				ListStrategy: options.ListMergeStrategy,
+				ListMergeKey: options.ListMergeKey,
+				MaxDepth:     options.MergeDepth,
+			})
+			recordDocumentOrigins(fileName, document)
 		}
 	}
-	addProperties(targetMap, options.Properties)
-
-	// set config
-	config = targetMap
-
-	parseConfigIfNecessary(config)
-
-	return nil
 }
 
-func addProperties(config Config, properties AnyMap) {
+func addProperties(config Config, properties AnyMap, origin string) {
 	for k, v := range properties {
 		addProperty2Map(config, k, v)
+		recordOrigin(normalizeConfigPath(k), origin)
 	}
 }
 
@@ -171,13 +313,50 @@ func addProperty2Map(cfg Config, key string, val interface{}) {
 		cfg[first] = val
 		return
 	}
-	subMap, ok := cfg[first]
-	if ok {
-		addProperty2Map(subMap.(Config), others, val)
-	} else {
-		cfg[first] = make(Config)
-		addProperty2Map(cfg[first].(Config), others, val)
+	switch subMap := cfg[first].(type) {
+	case Config:
+		addProperty2Map(subMap, others, val)
+	case AnyMap:
+		// merged sub map
+		addProperty2Map(Config(subMap), others, val)
+	default:
+		subConfig := make(Config)
+		cfg[first] = subConfig
+		addProperty2Map(subConfig, others, val)
+	}
+}
+
+// popConfigKey removes value of key like 'ioc-golang.profiles' from config, and parent maps that become empty
+func popConfigKey(cfg Config, key string) (interface{}, bool) {
+	first, others := separateFirstPrefixUnit(key)
+	val, ok := cfg[first]
+	if !ok || others == "" {
+		delete(cfg, first)
+		return val, ok
+	}
+	subMap, ok := toConfigMap(val)
+	if !ok {
+		return nil, false
+	}
+	if val, ok = popConfigKey(subMap, others); ok && len(subMap) == 0 {
+		delete(cfg, first)
 	}
+	return val, ok
+}
+
+// toStringSlice converts string or list value in config to string slice
+func toStringSlice(val interface{}) ([]string, bool) {
+	switch v := val.(type) {
+	case string:
+		return []string{v}, true
+	case []interface{}:
+		result := make([]string, 0, len(v))
+		for _, item := range v {
+			result = append(result, fmt.Sprintf("%v", item))
+		}
+		return result, true
+	}
+	return nil, false
 }
 
 // ----------------------------------------------------------------
@@ -218,12 +397,60 @@ func WithMergeDepth(mergeDepth uint8) Option {
 	}
 }
 
+// WithListMergeStrategy sets default strategy of merging lists under multiple config files, mergeKey is the item key
+// used by merge-by-key strategy
+func WithListMergeStrategy(strategy ListMergeStrategy, mergeKey ...string) Option {
+	return func(opts *Options) {
+		opts.ListMergeStrategy = strategy
+		if len(mergeKey) > 0 {
+			opts.ListMergeKey = mergeKey[0]
+		}
+	}
+}
+
 func AddProperty(key string, value interface{}) Option {
 	return func(opts *Options) {
 		opts.Properties[key] = value
 	}
 }
 
+// WithEncryptedValuePattern sets regexp pattern of encrypted config value, the first sub match is base64 encoded
+// cipher text
+func WithEncryptedValuePattern(pattern string) Option {
+	return func(opts *Options) {
+		opts.EncryptedValuePattern = pattern
+	}
+}
+
+// WithDecryptor sets decryptor of encrypted config value, like KMS-style decrypt providers
+func WithDecryptor(decryptor Decryptor) Option {
+	return func(opts *Options) {
+		opts.Decryptor = decryptor
+	}
+}
+
+// WithRemoteSource adds remote source of config, like config center
+func WithRemoteSource(source RemoteSource) Option {
+	return func(opts *Options) {
+		opts.RemoteSources = append(opts.RemoteSources, source)
+	}
+}
+
+// WithValidateMode validates all struct params before constructing, and returns all failures together
+func WithValidateMode() Option {
+	return func(opts *Options) {
+		opts.ValidateMode = true
+	}
+}
+
+// WithArgs parses command line args like '--ioc.<key>=<value>' and '--ioc-profile=dev',
+// which override properties from config files and API
+func WithArgs(args []string) Option {
+	return func(opts *Options) {
+		opts.Args = args
+	}
+}
+
 // ----------------------------------------------------------------
 
 // LoadConfigByPrefix prefix is like 'a.b.c' or 'a.b.<github.com/xxx/xx/xxx.Impl>.c', configStructPtr is interface ptr
@@ -238,7 +465,7 @@ func LoadConfigByPrefix(prefix string, configStructPtr interface{}) error {
 			realConfigProperties = append(realConfigProperties, v)
 		}
 	}
-	return loadProperty(realConfigProperties, 0, config, configStructPtr)
+	return loadProperty(realConfigProperties, 0, getConfig(), configStructPtr)
 }
 
 func GetActiveProfiles() []string {
@@ -288,6 +515,7 @@ func newOptions() *Options {
 		SearchPath:     make([]string, 0),
 		ProfilesActive: make([]string, 0),
 		Properties:     make(AnyMap, 0),
+		ArgProperties:  make(AnyMap, 0),
 	}
 }
 
@@ -297,6 +525,7 @@ func initOptions(opts ...Option) *Options {
 	for _, opt := range opts {
 		opt(options)
 	}
+	options.loadFromArgs()
 	options.validate()
 
 	return options
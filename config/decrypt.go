@@ -0,0 +1,331 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	perrors "github.com/pkg/errors"
+)
+
+const (
+	// DefaultEncryptedValuePattern matches config value like 'ENC(base64 cipher text)'
+	DefaultEncryptedValuePattern = `^ENC\((.*)\)$`
+
+	DecryptKeyEnvKey     = "IOC_GOLANG_CONFIG_DECRYPT_KEY"
+	DecryptKeyFileEnvKey = "IOC_GOLANG_CONFIG_DECRYPT_KEY_FILE"
+
+	MaskedValue = "******"
+)
+
+// Decryptor decrypts cipher text of encrypted config value, cipher text is base64 decoded before passed in.
+// Implement it to decrypt config with KMS-style providers, and set it with WithDecryptor.
+type Decryptor interface {
+	Decrypt(cipherText []byte) ([]byte, error)
+}
+
+var (
+	// decryptedPaths are paths of decrypted config values, path -> plain text
+	decryptedPaths     = make(map[string]string)
+	decryptedPathsLock sync.RWMutex
+)
+
+// parseEncryptedIfNecessary decrypts all config values matching encryptedValuePattern, like
+// 'password: ENC(a3NkamZsa3NqZGxma2pzbGRrZmpsc2tkamY=)', decryptor is lazy loaded by decryptorFactory only if any
+// encrypted value is found.
+func parseEncryptedIfNecessary(config Config, encryptedValuePattern string, decryptorFactory func() (Decryptor, error)) error {
+	pattern, err := regexp.Compile(encryptedValuePattern)
+	if err != nil {
+		return perrors.Errorf("[Config] Invalid encrypted value pattern %s, error = %s", encryptedValuePattern, err)
+	}
+	resetDecryptedPaths()
+	var decryptor Decryptor
+	return walkConfigStringValues(config, "", func(path, val string) (interface{}, error) {
+		matches := pattern.FindStringSubmatch(val)
+		if len(matches) < 2 {
+			return val, nil
+		}
+		if decryptor == nil {
+			if decryptor, err = decryptorFactory(); err != nil {
+				return val, perrors.Errorf("[Config] Decrypt config %s failed, error = %s", path, err)
+			}
+		}
+		cipherText, err := base64.StdEncoding.DecodeString(strings.TrimSpace(matches[1]))
+		if err != nil {
+			return val, perrors.Errorf("[Config] Decrypt config %s failed, cipher text is not base64 encoded, error = %s", path, err)
+		}
+		plainText, err := decryptor.Decrypt(cipherText)
+		if err != nil {
+			return val, perrors.Errorf("[Config] Decrypt config %s failed, error = %s", path, err)
+		}
+		recordDecryptedPath(path, string(plainText))
+		return string(plainText), nil
+	})
+}
+
+func resetDecryptedPaths() {
+	decryptedPathsLock.Lock()
+	defer decryptedPathsLock.Unlock()
+	decryptedPaths = make(map[string]string)
+}
+
+func recordDecryptedPath(path, plainText string) {
+	decryptedPathsLock.Lock()
+	defer decryptedPathsLock.Unlock()
+	decryptedPaths[path] = plainText
+}
+
+// IsDecryptedPath returns if config value of path is decrypted, path is like 'a.<github.com/xxx/xx/xxx.Impl>.c'
+func IsDecryptedPath(path string) bool {
+	decryptedPathsLock.RLock()
+	defer decryptedPathsLock.RUnlock()
+	_, ok := decryptedPaths[path]
+	return ok
+}
+
+// containsDecryptedPath returns if config value of path, or any value nested in it, is decrypted
+func containsDecryptedPath(path string) bool {
+	decryptedPathsLock.RLock()
+	defer decryptedPathsLock.RUnlock()
+	for decryptedPath := range decryptedPaths {
+		if decryptedPath == path || strings.HasPrefix(decryptedPath, path+YamlConfigSeparator) ||
+			strings.HasPrefix(decryptedPath, path+"[") {
+			return true
+		}
+	}
+	return false
+}
+
+/*
+MaskDecryptedFields returns copy of configStructPtr whose decrypted string fields are replaced by MaskedValue, it
+should be called before any param or config struct is printed to logs or debug server. prefix is the config path
+that configStructPtr is loaded from, like 'autowire.normal.<github.com/xxx/xx/xxx.Impl>.param', a field is decrypted
+if its path under prefix is decrypted. If prefix is unknown and empty, a field is decrypted if its value is decrypted
+from config path ending with its key path, like field 'Password' decrypted from 'xxx.param.password'.
+configStructPtr is returned as is if no field is decrypted.
+*/
+func MaskDecryptedFields(prefix string, configStructPtr interface{}) interface{} {
+	if configStructPtr == nil {
+		return nil
+	}
+	decryptedPathsLock.RLock()
+	defer decryptedPathsLock.RUnlock()
+	if len(decryptedPaths) == 0 {
+		return configStructPtr
+	}
+	masked, ok := maskDecryptedValue(reflect.ValueOf(configStructPtr), prefix, "")
+	if !ok {
+		return configStructPtr
+	}
+	return masked.Interface()
+}
+
+// maskDecryptedValue returns masked copy of value and true if any value in it is decrypted, keyPath is path of
+// value relative to prefix
+func maskDecryptedValue(value reflect.Value, prefix, keyPath string) (reflect.Value, bool) {
+	switch value.Kind() {
+	case reflect.String:
+		if isDecryptedValue(prefix, keyPath, value.String()) {
+			return reflect.ValueOf(MaskedValue).Convert(value.Type()), true
+		}
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return value, false
+		}
+		maskedElem, ok := maskDecryptedValue(value.Elem(), prefix, keyPath)
+		if !ok {
+			return value, false
+		}
+		if value.Kind() == reflect.Interface {
+			masked := reflect.New(value.Type()).Elem()
+			masked.Set(maskedElem)
+			return masked, true
+		}
+		masked := reflect.New(value.Type().Elem())
+		masked.Elem().Set(maskedElem)
+		return masked, true
+	case reflect.Struct:
+		var masked reflect.Value
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			key, inline, ok := getYamlFieldKey(field)
+			if !ok || field.PkgPath != "" {
+				// unexported embedded struct can't be set
+				continue
+			}
+			fieldKeyPath := keyPath
+			if !inline {
+				fieldKeyPath = joinConfigPath(keyPath, key)
+			}
+			maskedField, fieldMasked := maskDecryptedValue(value.Field(i), prefix, fieldKeyPath)
+			if !fieldMasked {
+				continue
+			}
+			if !masked.IsValid() {
+				masked = reflect.New(value.Type()).Elem()
+				masked.Set(value)
+			}
+			masked.Field(i).Set(maskedField)
+		}
+		if masked.IsValid() {
+			return masked, true
+		}
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return value, false
+		}
+		var masked reflect.Value
+		iter := value.MapRange()
+		for iter.Next() {
+			maskedElem, ok := maskDecryptedValue(iter.Value(), prefix, joinConfigPath(keyPath, iter.Key().String()))
+			if !ok {
+				continue
+			}
+			if !masked.IsValid() {
+				masked = reflect.MakeMapWithSize(value.Type(), value.Len())
+				copyIter := value.MapRange()
+				for copyIter.Next() {
+					masked.SetMapIndex(copyIter.Key(), copyIter.Value())
+				}
+			}
+			masked.SetMapIndex(iter.Key(), maskedElem)
+		}
+		if masked.IsValid() {
+			return masked, true
+		}
+	case reflect.Slice, reflect.Array:
+		var masked reflect.Value
+		for i := 0; i < value.Len(); i++ {
+			maskedElem, ok := maskDecryptedValue(value.Index(i), prefix, keyPath+"["+strconv.Itoa(i)+"]")
+			if !ok {
+				continue
+			}
+			if !masked.IsValid() {
+				masked = reflect.New(value.Type()).Elem()
+				if value.Kind() == reflect.Slice {
+					masked.Set(reflect.MakeSlice(value.Type(), value.Len(), value.Len()))
+					reflect.Copy(masked, value)
+				} else {
+					masked.Set(value)
+				}
+			}
+			masked.Index(i).Set(maskedElem)
+		}
+		if masked.IsValid() {
+			return masked, true
+		}
+	}
+	return value, false
+}
+
+// isDecryptedValue must be called with decryptedPathsLock held
+func isDecryptedValue(prefix, keyPath, value string) bool {
+	if prefix != "" {
+		_, ok := decryptedPaths[joinPrefixAndKeyPath(prefix, keyPath)]
+		return ok
+	}
+	if keyPath == "" {
+		return false
+	}
+	for path, plainText := range decryptedPaths {
+		if plainText == value && (path == keyPath || strings.HasSuffix(path, YamlConfigSeparator+keyPath)) {
+			return true
+		}
+	}
+	return false
+}
+
+func joinPrefixAndKeyPath(prefix, keyPath string) string {
+	if keyPath == "" {
+		return prefix
+	}
+	if strings.HasPrefix(keyPath, "[") {
+		return prefix + keyPath
+	}
+	return prefix + YamlConfigSeparator + keyPath
+}
+
+// AESGCMDecryptor is the built-in decryptor, cipher text is nonce followed by AES-GCM sealed data
+type AESGCMDecryptor struct {
+	aead cipher.AEAD
+}
+
+// NewAESGCMDecryptor creates AESGCMDecryptor with 16, 24 or 32 bytes key
+func NewAESGCMDecryptor(key []byte) (*AESGCMDecryptor, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &AESGCMDecryptor{
+		aead: aead,
+	}, nil
+}
+
+func (d *AESGCMDecryptor) Decrypt(cipherText []byte) ([]byte, error) {
+	nonceSize := d.aead.NonceSize()
+	if len(cipherText) < nonceSize {
+		return nil, perrors.New("cipher text too short")
+	}
+	return d.aead.Open(nil, cipherText[:nonceSize], cipherText[nonceSize:], nil)
+}
+
+// Encrypt is used to generate cipher text of AESGCMDecryptor, the result should be base64 encoded and set to
+// config like 'ENC(base64 result)'
+func (d *AESGCMDecryptor) Encrypt(plainText []byte) ([]byte, error) {
+	nonce := make([]byte, d.aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	return d.aead.Seal(nonce, nonce, plainText, nil), nil
+}
+
+// newDefaultDecryptor creates AESGCMDecryptor with base64 encoded key from env IOC_GOLANG_CONFIG_DECRYPT_KEY,
+// or from file pointed by env IOC_GOLANG_CONFIG_DECRYPT_KEY_FILE
+func newDefaultDecryptor() (Decryptor, error) {
+	encodedKey := os.Getenv(DecryptKeyEnvKey)
+	if isBlankString(encodedKey) {
+		keyFile := os.Getenv(DecryptKeyFileEnvKey)
+		if isBlankString(keyFile) {
+			return nil, perrors.Errorf("decrypt key not found, please set env %s or %s, or set decryptor with config.WithDecryptor",
+				DecryptKeyEnvKey, DecryptKeyFileEnvKey)
+		}
+		contents, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, perrors.Errorf("read decrypt key file %s failed, error = %s", keyFile, err)
+		}
+		encodedKey = string(contents)
+	}
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(encodedKey))
+	if err != nil {
+		return nil, perrors.Errorf("decrypt key is not base64 encoded, error = %s", err)
+	}
+	return NewAESGCMDecryptor(key)
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testDecryptKey      = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+	testPasswordPrefix  = "autowire.normal.<github.com/alibaba/ioc-golang/extension/db/gorm.GORMDB>.param.password"
+	testPlainTextSecret = "my-secret-password"
+)
+
+func encryptForTest(t *testing.T, plainText string) string {
+	key, err := base64.StdEncoding.DecodeString(testDecryptKey)
+	assert.Nil(t, err)
+	decryptor, err := NewAESGCMDecryptor(key)
+	assert.Nil(t, err)
+	cipherText, err := decryptor.Encrypt([]byte(plainText))
+	assert.Nil(t, err)
+	return base64.StdEncoding.EncodeToString(cipherText)
+}
+
+type reverseDecryptor struct {
+}
+
+func (r *reverseDecryptor) Decrypt(cipherText []byte) ([]byte, error) {
+	result := make([]byte, len(cipherText))
+	for i := range cipherText {
+		result[len(cipherText)-1-i] = cipherText[i]
+	}
+	return result, nil
+}
+
+func TestLoad_encrypted(t *testing.T) {
+	encrypted := fmt.Sprintf("ENC(%s)", encryptForTest(t, testPlainTextSecret))
+
+	t.Run("test decrypt with key from env", func(t *testing.T) {
+		defer clearEnv()
+		assert.Nil(t, os.Setenv(DecryptKeyEnvKey, testDecryptKey))
+		assert.Nil(t, Load(AddProperty(testPasswordPrefix, encrypted)))
+
+		password := ""
+		assert.Nil(t, LoadConfigByPrefix(testPasswordPrefix, &password))
+		assert.Equal(t, testPlainTextSecret, password)
+		assert.True(t, IsDecryptedPath(testPasswordPrefix))
+		assert.Equal(t, "param = &{Password:******}", fmt.Sprintf("param = %+v", MaskDecryptedFields("", &struct {
+			Password string
+		}{Password: password})))
+	})
+
+	t.Run("test decrypt with key from file", func(t *testing.T) {
+		defer clearEnv()
+		keyFile := filepath.Join(t.TempDir(), "key")
+		assert.Nil(t, os.WriteFile(keyFile, []byte(testDecryptKey+"\n"), 0600))
+		assert.Nil(t, os.Setenv(DecryptKeyFileEnvKey, keyFile))
+		assert.Nil(t, Load(AddProperty(testPasswordPrefix, encrypted)))
+
+		password := ""
+		assert.Nil(t, LoadConfigByPrefix(testPasswordPrefix, &password))
+		assert.Equal(t, testPlainTextSecret, password)
+	})
+
+	t.Run("test decrypt without key", func(t *testing.T) {
+		defer clearEnv()
+		err := Load(AddProperty(testPasswordPrefix, encrypted))
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), testPasswordPrefix))
+	})
+
+	t.Run("test decrypt with custom decryptor and pattern", func(t *testing.T) {
+		assert.Nil(t, Load(
+			AddProperty(testPasswordPrefix, "KMS["+base64.StdEncoding.EncodeToString([]byte("drowssap"))+"]"),
+			AddProperty("autowire.config.strValue", "ENC(not-decrypted)"),
+			WithEncryptedValuePattern(`^KMS\[(.*)\]$`),
+			WithDecryptor(&reverseDecryptor{}),
+		))
+
+		password := ""
+		assert.Nil(t, LoadConfigByPrefix(testPasswordPrefix, &password))
+		assert.Equal(t, "password", password)
+		strValue := ""
+		assert.Nil(t, LoadConfigByPrefix("autowire.config.strValue", &strValue))
+		assert.Equal(t, "ENC(not-decrypted)", strValue)
+	})
+}
+
+type maskTestParam struct {
+	Address  string
+	Password string
+	Token    *string `yaml:"api-token"`
+	Sub      maskTestSubParam
+	Hosts    []string
+	Labels   map[string]string
+}
+
+type maskTestSubParam struct {
+	Secret string
+}
+
+func TestMaskDecryptedFields(t *testing.T) {
+	const prefix = "autowire.normal.<github.com/alibaba/ioc-golang/test.Impl>.param"
+	encode := func(plainText string) string {
+		cipherText, _ := (&reverseDecryptor{}).Decrypt([]byte(plainText))
+		return "ENC(" + base64.StdEncoding.EncodeToString(cipherText) + ")"
+	}
+	assert.Nil(t, Load(
+		AddProperty(prefix+".address", "localhost:1"),
+		AddProperty(prefix+".password", encode("1")),
+		AddProperty(prefix+".api-token", encode("on")),
+		AddProperty(prefix+".sub.secret", encode("sub-secret")),
+		AddProperty(prefix+".hosts", []interface{}{"host-a", encode("host-b")}),
+		AddProperty(prefix+".labels.key", encode("label")),
+		WithDecryptor(&reverseDecryptor{}),
+	))
+	param := &maskTestParam{}
+	assert.Nil(t, LoadConfigByPrefix(prefix, param))
+	assert.Equal(t, "1", param.Password)
+
+	for _, p := range []string{prefix, ""} {
+		masked := MaskDecryptedFields(p, param).(*maskTestParam)
+		// short secret only masks the field decrypted, not other values containing it
+		assert.Equal(t, "localhost:1", masked.Address, p)
+		assert.Equal(t, MaskedValue, masked.Password, p)
+		assert.Equal(t, MaskedValue, *masked.Token, p)
+		assert.Equal(t, MaskedValue, masked.Sub.Secret, p)
+		assert.Equal(t, []string{"host-a", MaskedValue}, masked.Hosts, p)
+		assert.Equal(t, map[string]string{"key": MaskedValue}, masked.Labels, p)
+	}
+	// param itself is not changed
+	assert.Equal(t, "1", param.Password)
+	assert.Equal(t, "on", *param.Token)
+	assert.Equal(t, "host-b", param.Hosts[1])
+	assert.Equal(t, "label", param.Labels["key"])
+
+	// field with the same value but different key is not masked
+	other := &struct {
+		Port string
+	}{Port: "1"}
+	assert.Equal(t, other, MaskDecryptedFields("", other))
+	assert.Equal(t, other, MaskDecryptedFields("autowire.normal.<github.com/alibaba/ioc-golang/test.Other>.param", other))
+}
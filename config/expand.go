@@ -4,42 +4,83 @@ import (
 	"fmt"
 	"os"
 	"strings"
+
+	perrors "github.com/pkg/errors"
+
+	"github.com/alibaba/ioc-golang/logger"
 )
 
 const (
 	EnvPrefixKey = "${"
 	EnvSuffixKey = "}"
 	And          = "&"
+
+	// EscapedPrefixKey is escaped EnvPrefixKey, '$${literal}' is expanded to '${literal}'
+	EscapedPrefixKey = "$${"
+	// DefaultValueSeparator separates placeholder key and default value, like '${REDIS_HOST:localhost}'
+	DefaultValueSeparator = ":"
+
+	OriginDefault = "default"
 )
 
+/*
+ExpandConfigEnvValue expands env placeholders in string value, like:
+
+```
+${REDIS_ADDRESS}                           # the whole value
+${REDIS_ADDRESS:localhost:6379}            # with default value if env is not set or empty
+redis://${HOST:localhost}:${PORT:6379}/0   # inline interpolation
+```
+
+env placeholder without default value is kept if env is not set or empty. Config placeholders like
+'${autowire.config.address}' and escaped placeholders like '$${literal}' are kept, which are expanded by
+ExpandConfigNestedValue.
+*/
 func ExpandConfigEnvValue(targetValue interface{}) (interface{}, bool) {
-	if tv, ok := targetValue.(string); ok {
-		// ${ Xxx }
-		if strings.HasPrefix(tv, EnvPrefixKey) && strings.HasSuffix(tv, EnvSuffixKey) && isEnv(tv) {
-			expandValue := os.ExpandEnv(tv)
-			if expandValue != "" {
-				return expandValue, true
-			}
-		}
+	tv, ok := targetValue.(string)
+	if !ok || !strings.Contains(tv, EnvPrefixKey) {
+		return targetValue, false
+	}
+	resolver := &placeholderResolver{
+		envOnly: true,
 	}
-	return targetValue, false
+	result, err := resolver.resolve(tv)
+	if err != nil {
+		logger.Red("[Config] Expand env value %s failed, error = %s", tv, err)
+		return targetValue, false
+	}
+	return result, len(resolver.sources) > 0
 }
 
-func ExpandConfigNestedValue(targetValue interface{}) (interface{}, bool) {
-	if tv, ok := targetValue.(string); ok {
-		if strings.HasPrefix(tv, EnvPrefixKey) && strings.HasSuffix(tv, EnvSuffixKey) && !isEnv(tv) {
-			// try nested parsing
-			var nestedValue interface{}
-			// ${autowire.normal.<github.com/alibaba/ioc-golang/extension/state/redis.Redis>.expand.address}
-			err := LoadConfigByPrefix(tv[2:len(tv)-1], &nestedValue)
-			if err != nil {
-				return nestedValue, false
-			}
+/*
+ExpandConfigNestedValue expands all placeholders in string value, which can be env placeholders and nested config
+placeholders, like:
 
-			return nestedValue, true
-		}
+```
+${autowire.normal.<github.com/alibaba/ioc-golang/extension/state/redis.Redis>.expand.address}
+${app.host:localhost}:${PORT:${app.port}}
+$${literal}                                # escaped, expanded to '${literal}'
+```
+
+if the whole value is one config placeholder, the referenced value is returned with its own type, like map or int.
+*/
+func ExpandConfigNestedValue(targetValue interface{}) (interface{}, bool) {
+	tv, ok := targetValue.(string)
+	if !ok || !strings.Contains(tv, EnvPrefixKey) {
+		return targetValue, false
+	}
+	resolver := &placeholderResolver{
+		config: getConfig(),
 	}
-	return targetValue, false
+	result, err := resolver.resolve(tv)
+	if err != nil {
+		logger.Red("[Config] Expand nested value %s failed, error = %s", tv, err)
+		return targetValue, false
+	}
+	if resultStr, ok := result.(string); ok {
+		return result, resultStr != tv
+	}
+	return result, true
 }
 
 func ExpandConfigValueIfNecessary(targetValue interface{}) interface{} {
@@ -48,35 +89,288 @@ func ExpandConfigValueIfNecessary(targetValue interface{}) interface{} {
 	return result
 }
 
-func parseEnvIfNecessary(config Config) {
-	for k, v := range config {
-		if val, ok := v.(string); ok {
-			if expandValue, expand := ExpandConfigEnvValue(val); expand {
-				config[k] = expandValue
-				continue
+func parseEnvIfNecessary(config Config) error {
+	return walkConfigStringValues(config, "", func(path, val string) (interface{}, error) {
+		if !strings.Contains(val, EnvPrefixKey) {
+			return val, nil
+		}
+		resolver := &placeholderResolver{
+			envOnly: true,
+		}
+		expandValue, err := resolver.resolve(val)
+		if err != nil {
+			return val, perrors.Errorf("[Config] Expand config %s failed, error = %s", path, err)
+		}
+		recordResolvedOrigin(path, resolver)
+		return expandValue, nil
+	})
+}
+
+func parseNestedIfNecessary(config Config) error {
+	// nested placeholders are resolved from snapshot, so that they are not affected by resolving order
+	snapshot, _ := copyConfigValue(config).(Config)
+	return walkConfigStringValues(config, "", func(path, val string) (interface{}, error) {
+		if !strings.Contains(val, EnvPrefixKey) {
+			return val, nil
+		}
+		resolver := &placeholderResolver{
+			config:    snapshot,
+			resolving: []string{path},
+		}
+		expandValue, err := resolver.resolve(val)
+		if err != nil {
+			return val, perrors.Errorf("[Config] Expand config %s failed, error = %s", path, err)
+		}
+		recordResolvedOrigin(path, resolver)
+		return expandValue, nil
+	})
+}
+
+func parseConfigIfNecessary(config Config, opts *Options) error {
+	if err := parseEnvIfNecessary(config); err != nil {
+		return err
+	}
+	if err := parseEncryptedIfNecessary(config, opts.EncryptedValuePattern, opts.getDecryptor); err != nil {
+		return err
+	}
+	return parseNestedIfNecessary(config)
+}
+
+// recordResolvedOrigin records origin of value with resolved placeholders, origin of the whole value placeholder is
+// like 'env:REDIS_ADDRESS', and origin of inline placeholders is like 'config.yaml:3 <- env:HOST, default:PORT'
+func recordResolvedOrigin(path string, resolver *placeholderResolver) {
+	if len(resolver.sources) == 0 {
+		return
+	}
+	if resolver.whole {
+		recordOrigin(path, resolver.sources[len(resolver.sources)-1])
+		return
+	}
+	origin := getLoadingOrigin(path)
+	if origin != "" {
+		origin += " <- "
+	}
+	recordOrigin(path, origin+strings.Join(resolver.sources, ", "))
+}
+
+type placeholderResolver struct {
+	// envOnly resolves env placeholders only, config and escaped placeholders are kept
+	envOnly bool
+	// config is where config placeholders are resolved from
+	config Config
+	// resolving is stack of config paths being resolved, which is used to detect circular reference
+	resolving []string
+	// sources are origins of resolved placeholders
+	sources []string
+	// whole is true if the whole value is one resolved placeholder
+	whole bool
+}
+
+func (r *placeholderResolver) resolve(val string) (interface{}, error) {
+	var builder strings.Builder
+	for i := 0; i < len(val); {
+		escaped := strings.HasPrefix(val[i:], EscapedPrefixKey)
+		if !escaped && !strings.HasPrefix(val[i:], EnvPrefixKey) {
+			builder.WriteByte(val[i])
+			i++
+			continue
+		}
+		start := i
+		if escaped {
+			start++
+		}
+		end := findPlaceholderEnd(val, start+len(EnvPrefixKey))
+		if end < 0 {
+			// not closed, keep the rest
+			builder.WriteString(val[i:])
+			break
+		}
+		placeholder := val[start : end+len(EnvSuffixKey)]
+		if escaped {
+			if r.envOnly {
+				builder.WriteString(val[i : end+len(EnvSuffixKey)])
+			} else {
+				builder.WriteString(placeholder)
 			}
-		} else if subConfig, ok := v.(Config); ok {
-			parseEnvIfNecessary(subConfig)
+			i = end + len(EnvSuffixKey)
+			continue
+		}
+		resolved, ok, err := r.resolvePlaceholder(val[start+len(EnvPrefixKey) : end])
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			builder.WriteString(placeholder)
+		} else if i == 0 && end+len(EnvSuffixKey) == len(val) {
+			// the whole value is one placeholder, keep type of resolved value
+			r.whole = true
+			return resolved, nil
+		} else {
+			builder.WriteString(formatPropertyValue(resolved))
 		}
+		i = end + len(EnvSuffixKey)
 	}
+	return builder.String(), nil
 }
 
-func parseNestedIfNecessary(config Config) {
-	for k, v := range config {
-		if val, ok := v.(string); ok {
-			if expandValue, expand := ExpandConfigNestedValue(val); expand {
-				config[k] = expandValue
-				continue
+// resolvePlaceholder resolves placeholder content like 'REDIS_HOST:localhost', returns false if it is not resolved
+func (r *placeholderResolver) resolvePlaceholder(content string) (interface{}, bool, error) {
+	key, defaultValue, hasDefault := splitPlaceholder(content)
+	if isEnvKey(key) {
+		if envValue := os.Getenv(key); envValue != "" {
+			r.sources = append(r.sources, fmt.Sprintf("%s:%s", OriginEnv, key))
+			return envValue, true, nil
+		}
+	} else if !r.envOnly {
+		path := normalizeConfigPath(key)
+		for i, resolvingPath := range r.resolving {
+			if resolvingPath == path {
+				return nil, false, perrors.Errorf("circular reference found: %s", strings.Join(append(r.resolving[i:], path), " -> "))
+			}
+		}
+		if nestedValue, ok := lookupConfigValue(r.config, path); ok {
+			subResolver := &placeholderResolver{
+				config:    r.config,
+				resolving: append(append(make([]string, 0, len(r.resolving)+1), r.resolving...), path),
+			}
+			resolved, err := subResolver.resolveValue(nestedValue)
+			if err != nil {
+				return nil, false, err
+			}
+			nestedOrigin := getLoadingOrigin(path)
+			if len(subResolver.sources) > 0 {
+				nestedOrigin = strings.Join(subResolver.sources, ", ")
+			}
+			r.sources = append(r.sources, fmt.Sprintf("%s%s%s -> %s", EnvPrefixKey, key, EnvSuffixKey, nestedOrigin))
+			return resolved, true, nil
+		}
+	} else {
+		// config placeholder is resolved later
+		return nil, false, nil
+	}
+	if !hasDefault {
+		return nil, false, nil
+	}
+	defaultResolver := &placeholderResolver{
+		envOnly:   r.envOnly,
+		config:    r.config,
+		resolving: r.resolving,
+	}
+	resolved, err := defaultResolver.resolve(defaultValue)
+	if err != nil {
+		return nil, false, err
+	}
+	r.sources = append(r.sources, defaultResolver.sources...)
+	r.sources = append(r.sources, fmt.Sprintf("%s:%s", OriginDefault, key))
+	return resolved, true, nil
+}
+
+// resolveValue resolves all string values in value, maps and lists are copied
+func (r *placeholderResolver) resolveValue(value interface{}) (interface{}, error) {
+	switch val := value.(type) {
+	case string:
+		if !strings.Contains(val, EnvPrefixKey) {
+			return val, nil
+		}
+		return r.resolve(val)
+	case Config, AnyMap:
+		subMap, _ := toConfigMap(val)
+		result := make(Config, len(subMap))
+		for k, v := range subMap {
+			resolved, err := r.resolveValue(v)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = resolved
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, 0, len(val))
+		for _, v := range val {
+			resolved, err := r.resolveValue(v)
+			if err != nil {
+				return nil, err
 			}
-		} else if subConfig, ok := v.(Config); ok {
-			parseNestedIfNecessary(subConfig)
+			result = append(result, resolved)
 		}
+		return result, nil
 	}
+	return value, nil
 }
 
-func parseConfigIfNecessary(config Config) {
-	parseEnvIfNecessary(config)
-	parseNestedIfNecessary(config)
+// findPlaceholderEnd returns index of EnvSuffixKey that closes placeholder content starting from start, nested
+// placeholders in default value are skipped
+func findPlaceholderEnd(val string, start int) int {
+	depth := 0
+	for i := start; i < len(val); i++ {
+		switch val[i] {
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// splitPlaceholder splits placeholder content to key and default value by the first DefaultValueSeparator outside
+// '<>' and nested placeholders
+func splitPlaceholder(content string) (string, string, bool) {
+	depth := 0
+	for i := 0; i < len(content); i++ {
+		switch content[i] {
+		case '<', '{':
+			depth++
+		case '>', '}':
+			depth--
+		case DefaultValueSeparator[0]:
+			if depth == 0 {
+				return strings.TrimSpace(content[:i]), content[i+len(DefaultValueSeparator):], true
+			}
+		}
+	}
+	return strings.TrimSpace(content), "", false
+}
+
+// lookupConfigValue returns value of path like 'a.b.<github.com/xxx/xx/xxx.Impl>.c' in config
+func lookupConfigValue(cfg Config, path string) (interface{}, bool) {
+	var value interface{} = map[string]interface{}(cfg)
+	for _, unit := range splitPrefix2Units(path) {
+		if unit == "" {
+			continue
+		}
+		subMap, ok := toConfigMap(value)
+		if !ok {
+			return nil, false
+		}
+		if value, ok = subMap[unit]; !ok {
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// copyConfigValue deep copies maps and lists in value
+func copyConfigValue(value interface{}) interface{} {
+	switch val := value.(type) {
+	case Config, AnyMap:
+		subMap, _ := toConfigMap(val)
+		result := make(Config, len(subMap))
+		for k, v := range subMap {
+			result[k] = copyConfigValue(v)
+		}
+		return result
+	case []interface{}:
+		result := make([]interface{}, 0, len(val))
+		for _, v := range val {
+			result = append(result, copyConfigValue(v))
+		}
+		return result
+	}
+	return value
 }
 
 func expandIfNecessary(targetValue string) string {
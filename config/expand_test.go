@@ -0,0 +1,118 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandConfigEnvValue(t *testing.T) {
+	defer func() {
+		_ = os.Unsetenv("EXPAND_HOST")
+		_ = os.Unsetenv("EXPAND_PORT2")
+	}()
+	assert.Nil(t, os.Setenv("EXPAND_HOST", "redis-host"))
+	assert.Nil(t, os.Setenv("EXPAND_PORT2", "6380"))
+
+	tests := []struct {
+		name       string
+		value      interface{}
+		want       interface{}
+		wantExpand bool
+	}{
+		{name: "whole env", value: "${EXPAND_HOST}", want: "redis-host", wantExpand: true},
+		{name: "whole env not set", value: "${EXPAND_NOT_SET}", want: "${EXPAND_NOT_SET}"},
+		{name: "whole env with default", value: "${EXPAND_NOT_SET:localhost:6379}", want: "localhost:6379", wantExpand: true},
+		{name: "inline env", value: "redis://${EXPAND_HOST:localhost}:${EXPAND_PORT2:6379}/0", want: "redis://redis-host:6380/0", wantExpand: true},
+		{name: "inline env with default", value: "redis://${EXPAND_NOT_SET:localhost}:${EXPAND_PORT:6379}/0", want: "redis://localhost:6379/0", wantExpand: true},
+		{name: "nested default", value: "${EXPAND_NOT_SET:${EXPAND_HOST}}", want: "redis-host", wantExpand: true},
+		{name: "config placeholder kept", value: "${app.host:localhost}:${EXPAND_PORT2}", want: "${app.host:localhost}:6380", wantExpand: true},
+		{name: "escaped kept", value: "$${EXPAND_HOST}", want: "$${EXPAND_HOST}"},
+		{name: "not closed", value: "${EXPAND_HOST", want: "${EXPAND_HOST"},
+		{name: "not string", value: 123, want: 123},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, expand := ExpandConfigEnvValue(tt.value)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantExpand, expand)
+		})
+	}
+}
+
+func TestLoad_expandNested(t *testing.T) {
+	defer func() {
+		_ = os.Unsetenv("EXPAND_PORT")
+	}()
+	assert.Nil(t, os.Setenv("EXPAND_PORT", "6380"))
+
+	t.Run("test expand nested and inline values", func(t *testing.T) {
+		assert.Nil(t, Load(
+			AddProperty("app.host", "redis-host"),
+			AddProperty("app.db", 3),
+			AddProperty("app.redis", map[string]interface{}{"address": "${app.host}:${EXPAND_PORT}"}),
+			AddProperty("app.url", "redis://${app.host}:${EXPAND_PORT:6379}/${app.db}"),
+			AddProperty("app.default-url", "redis://${app.not-found:localhost}:${EXPAND_NOT_SET:${app.db}}"),
+			AddProperty("app.ref-url", "${app.url}"),
+			AddProperty("app.ref-redis", "${app.redis}"),
+			AddProperty("app.escaped", "$${app.host} is ${app.host}"),
+			AddProperty("app.kept", "${app.not-found}"),
+		))
+		url := ""
+		assert.Nil(t, LoadConfigByPrefix("app.url", &url))
+		assert.Equal(t, "redis://redis-host:6380/3", url)
+		assert.Nil(t, LoadConfigByPrefix("app.default-url", &url))
+		assert.Equal(t, "redis://localhost:3", url)
+		assert.Nil(t, LoadConfigByPrefix("app.ref-url", &url))
+		assert.Equal(t, "redis://redis-host:6380/3", url)
+		escaped := ""
+		assert.Nil(t, LoadConfigByPrefix("app.escaped", &escaped))
+		assert.Equal(t, "${app.host} is redis-host", escaped)
+		kept := ""
+		assert.Nil(t, LoadConfigByPrefix("app.kept", &kept))
+		assert.Equal(t, "${app.not-found}", kept)
+		redis := make(map[string]string)
+		assert.Nil(t, LoadConfigByPrefix("app.ref-redis", &redis))
+		assert.Equal(t, map[string]string{"address": "redis-host:6380"}, redis)
+
+		assert.Equal(t, "api <- env:EXPAND_PORT <- ${app.host} -> api, ${app.db} -> api", GetOrigin("app.url"))
+		assert.Equal(t, "${app.url} -> ${app.host} -> api, ${app.db} -> api", GetOrigin("app.ref-url"))
+	})
+
+	t.Run("test expand circular reference", func(t *testing.T) {
+		err := Load(
+			AddProperty("app.a", "prefix-${app.b}"),
+			AddProperty("app.b", "${app.c:default}"),
+			AddProperty("app.c", "${app.a}"),
+		)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "app.a -> app.b -> app.c -> app.a") ||
+			strings.Contains(err.Error(), "app.b -> app.c -> app.a -> app.b") ||
+			strings.Contains(err.Error(), "app.c -> app.a -> app.b -> app.c"))
+	})
+
+	t.Run("test expand with public api", func(t *testing.T) {
+		assert.Nil(t, Load(AddProperty("app.host", "redis-host")))
+		got, expand := ExpandConfigNestedValue("${app.host}:${EXPAND_PORT}")
+		assert.True(t, expand)
+		assert.Equal(t, "redis-host:6380", got)
+		assert.Equal(t, "redis-host:6380", ExpandConfigValueIfNecessary("${app.host}:${EXPAND_PORT}"))
+	})
+}
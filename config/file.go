@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 
 	perrors "github.com/pkg/errors"
@@ -37,17 +38,22 @@ func loadProperty(splitedConfigName []string, index int, tempConfigMap Config, c
 		return perrors.Errorf("property %s's key %s not found", splitedConfigName, splitedConfigName[index])
 	}
 	if index+1 == len(splitedConfigName) {
-		targetConfigByte, err := yaml.Marshal(subConfig)
-		if err != nil {
+		targetConfigNode := &yaml.Node{}
+		if err := targetConfigNode.Encode(subConfig); err != nil {
 			return perrors.Errorf("property %s's key %s invalid, error = %s", splitedConfigName, splitedConfigName[index], err)
 		}
-		err = yaml.Unmarshal(targetConfigByte, configStructPtr)
-		if err != nil {
-			return perrors.Errorf("property %s's key %s doesn't match type %+v, error = %s", splitedConfigName, splitedConfigName[index], configStructPtr, err)
+		path := ""
+		for _, unit := range splitedConfigName {
+			path = joinConfigPath(path, unit)
+		}
+		resolveArgNodes(targetConfigNode, reflect.TypeOf(configStructPtr), path)
+		if err := targetConfigNode.Decode(configStructPtr); err != nil {
+			return perrors.Errorf("property %s's key %s doesn't match type %+v, error = %s",
+				splitedConfigName, splitedConfigName[index], MaskDecryptedFields(path, configStructPtr), err)
 		}
 		return nil
 	}
-	subMap, ok := subConfig.(Config)
+	subMap, ok := toConfigMap(subConfig)
 	if !ok {
 		return perrors.Errorf("property %s's key %s of config is not map[string]string, which is %+v", splitedConfigName,
 			splitedConfigName[index], subConfig)
@@ -29,6 +29,9 @@ func clearEnv() {
 	os.Unsetenv(SearchPathEnvKey)
 	os.Unsetenv(NameEnvKey)
 	os.Unsetenv(ActiveProfileEnvKey)
+	os.Unsetenv(DecryptKeyEnvKey)
+	os.Unsetenv(DecryptKeyFileEnvKey)
+	os.Unsetenv(ListMergeEnvKey)
 }
 
 func Test_searchConfigFiles(t *testing.T) {
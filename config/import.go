@@ -0,0 +1,104 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	perrors "github.com/pkg/errors"
+
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+const (
+	// ImportKey is the key of yaml document that imports other config files, like:
+	//
+	// ioc-golang:
+	//   config:
+	//     import: [common-redis.yaml, optional:/etc/app/override.yaml]
+	//
+	// relative paths are resolved from the directory of the importing file. Imported files are merged in order before
+	// the importing document, so later imports override earlier ones, and the importing document overrides all imports.
+	ImportKey = "ioc-golang.config.import"
+	// OptionalImportPrefix marks the imported file as optional, which is skipped if not exists
+	OptionalImportPrefix = "optional:"
+
+	importChainSeparator = " -> "
+)
+
+// mergeImports merges config files imported by document of importChain's last file into targetMap
+func mergeImports(targetMap Config, document Config, importChain []string, options *Options) (Config, error) {
+	importsVal, ok := popConfigKey(document, ImportKey)
+	if !ok {
+		return targetMap, nil
+	}
+	imports, ok := toStringSlice(importsVal)
+	if !ok {
+		return targetMap, perrors.Errorf("%s should be string or list of file paths, but got %v, import chain: %s",
+			ImportKey, importsVal, formatImportChain(importChain))
+	}
+	baseDir := filepath.Dir(importChain[len(importChain)-1])
+	for _, importPath := range imports {
+		optional := strings.HasPrefix(importPath, OptionalImportPrefix)
+		importPath = strings.TrimSpace(strings.TrimPrefix(importPath, OptionalImportPrefix))
+		if !filepath.IsAbs(importPath) {
+			importPath = filepath.Join(baseDir, importPath)
+		}
+		importPath = filepath.Clean(importPath)
+		currentChain := append(append(make([]string, 0, len(importChain)+1), importChain...), importPath)
+		if importChainContains(importChain, importPath) {
+			return targetMap, perrors.Errorf("[Config] Import cycle detected, import chain: %s", formatImportChain(currentChain))
+		}
+		contents, err := ioutil.ReadFile(importPath)
+		if err != nil {
+			if optional {
+				logger.Blue("[Config] Skip optional imported config file %s, error = %s", importPath, err)
+				continue
+			}
+			return targetMap, perrors.Errorf("[Config] Read imported config file failed, import chain: %s, error = %s",
+				formatImportChain(currentChain), err)
+		}
+		logger.Blue("[Config] Loading imported config file %s", importPath)
+		if targetMap, err = mergeConfigDocuments(targetMap, contents, currentChain, options); err != nil {
+			return targetMap, err
+		}
+	}
+	return targetMap, nil
+}
+
+// importChainContains compares absolute paths, so that the same file imported by different relative paths is found
+func importChainContains(importChain []string, importPath string) bool {
+	absImportPath := toAbsImportPath(importPath)
+	for _, path := range importChain {
+		if toAbsImportPath(path) == absImportPath {
+			return true
+		}
+	}
+	return false
+}
+
+func toAbsImportPath(path string) string {
+	if absPath, err := filepath.Abs(path); err == nil {
+		return absPath
+	}
+	return filepath.Clean(path)
+}
+
+func formatImportChain(importChain []string) string {
+	return strings.Join(importChain, importChainSeparator)
+}
@@ -0,0 +1,77 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad_import(t *testing.T) {
+	type redis struct {
+		Address string
+		DB      int
+	}
+	type app struct {
+		Name    string
+		Timeout int
+	}
+	configFile, _ := filepath.Abs("./test/import/ioc_golang.yaml")
+	commonRedisFile, _ := filepath.Abs("./test/import/common-redis.yaml")
+
+	t.Run("test load with imports", func(t *testing.T) {
+		assert.Nil(t, Load(WithAbsPath(configFile)))
+		gotRedis := redis{}
+		assert.Nil(t, LoadConfigByPrefix("redis", &gotRedis))
+		assert.Equal(t, redis{Address: "localhost:6379", DB: 1}, gotRedis)
+		gotApp := app{}
+		assert.Nil(t, LoadConfigByPrefix("app", &gotApp))
+		assert.Equal(t, app{Name: "import", Timeout: 3}, gotApp)
+		assert.Equal(t, commonRedisFile+":2", GetOrigin("redis.address"))
+		assert.Equal(t, configFile+":8", GetOrigin("app.name"))
+		_, err := GetProperties("ioc-golang")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("test load with imports of profile document", func(t *testing.T) {
+		assert.Nil(t, Load(WithAbsPath(configFile), WithProfilesActive("dev")))
+		gotRedis := redis{}
+		assert.Nil(t, LoadConfigByPrefix("redis", &gotRedis))
+		assert.Equal(t, redis{Address: "dev:6379", DB: 1}, gotRedis)
+	})
+
+	t.Run("test load with import cycle", func(t *testing.T) {
+		cycleFile, _ := filepath.Abs("./test/import/cycle/a.yaml")
+		cycleImportedFile, _ := filepath.Abs("./test/import/cycle/b.yaml")
+		err := Load(WithAbsPath(cycleFile))
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), strings.Join([]string{cycleFile, cycleImportedFile, cycleFile}, importChainSeparator)))
+
+		err = Load(WithAbsPath(filepath.Join(filepath.Dir(cycleFile), "..", "cycle") + "/./a.yaml"))
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "import chain: "+strings.Join([]string{cycleFile, cycleImportedFile, cycleFile}, importChainSeparator)))
+	})
+
+	t.Run("test load with missing import", func(t *testing.T) {
+		missingFile, _ := filepath.Abs("./test/import/missing.yaml")
+		err := Load(WithAbsPath(missingFile))
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), missingFile+importChainSeparator+filepath.Join(filepath.Dir(missingFile), "not-exist.yaml")))
+	})
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+)
+
+type ChangeType string
+
+const (
+	ChangeTypeAdded    ChangeType = "added"
+	ChangeTypeModified ChangeType = "modified"
+	ChangeTypeDeleted  ChangeType = "deleted"
+)
+
+// ChangeEvent is change of one leaf of config tree, lists are treated as leaves
+type ChangeEvent struct {
+	// Path is like 'autowire.normal.<github.com/alibaba/ioc-golang/extension/state/redis.Redis>.param.address'
+	Path     string
+	Type     ChangeType
+	OldValue interface{}
+	NewValue interface{}
+}
+
+// ChangeListener is called with all changed leaves under the prefix it listens to
+type ChangeListener func(events []*ChangeEvent)
+
+type listenerEntry struct {
+	prefix   string
+	listener ChangeListener
+}
+
+var (
+	listeners     = make(map[int]*listenerEntry)
+	listenersID   = 0
+	listenersLock sync.RWMutex
+)
+
+// AddChangeListener listens to changes of config under prefix like 'a.b.<github.com/xxx/xx/xxx.Impl>.c', empty
+// prefix listens to all changes. It returns function to remove the listener.
+func AddChangeListener(prefix string, listener ChangeListener) func() {
+	listenersLock.Lock()
+	defer listenersLock.Unlock()
+	listenersID++
+	id := listenersID
+	listeners[id] = &listenerEntry{
+		prefix:   normalizeConfigPath(prefix),
+		listener: listener,
+	}
+	return func() {
+		listenersLock.Lock()
+		defer listenersLock.Unlock()
+		delete(listeners, id)
+	}
+}
+
+func notifyListeners(events []*ChangeEvent) {
+	if len(events) == 0 {
+		return
+	}
+	listenersLock.RLock()
+	ids := make([]int, 0, len(listeners))
+	for id := range listeners {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	entries := make([]*listenerEntry, 0, len(ids))
+	for _, id := range ids {
+		entries = append(entries, listeners[id])
+	}
+	listenersLock.RUnlock()
+
+	for _, entry := range entries {
+		matchedEvents := make([]*ChangeEvent, 0)
+		for _, event := range events {
+			if entry.prefix == "" || event.Path == entry.prefix || isSubPath(event.Path, entry.prefix) {
+				matchedEvents = append(matchedEvents, event)
+			}
+		}
+		if len(matchedEvents) > 0 {
+			entry.listener(matchedEvents)
+		}
+	}
+}
+
+// diffConfig returns changes of leaves from oldConfig to newConfig, sorted by path
+func diffConfig(oldConfig, newConfig Config) []*ChangeEvent {
+	oldLeaves := make(map[string]interface{})
+	collectLeaves(map[string]interface{}(oldConfig), "", oldLeaves)
+	newLeaves := make(map[string]interface{})
+	collectLeaves(map[string]interface{}(newConfig), "", newLeaves)
+
+	events := make([]*ChangeEvent, 0)
+	for path, newValue := range newLeaves {
+		oldValue, ok := oldLeaves[path]
+		if !ok {
+			events = append(events, &ChangeEvent{Path: path, Type: ChangeTypeAdded, NewValue: newValue})
+		} else if !reflect.DeepEqual(oldValue, newValue) {
+			events = append(events, &ChangeEvent{Path: path, Type: ChangeTypeModified, OldValue: oldValue, NewValue: newValue})
+		}
+	}
+	for path, oldValue := range oldLeaves {
+		if _, ok := newLeaves[path]; !ok {
+			events = append(events, &ChangeEvent{Path: path, Type: ChangeTypeDeleted, OldValue: oldValue})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Path < events[j].Path
+	})
+	return events
+}
+
+func collectLeaves(value interface{}, path string, leaves map[string]interface{}) {
+	if subMap, ok := toConfigMap(value); ok && len(subMap) > 0 {
+		for k, v := range subMap {
+			collectLeaves(v, joinConfigPath(path, k), leaves)
+		}
+		return
+	}
+	if path != "" {
+		leaves[path] = value
+	}
+}
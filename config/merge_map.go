@@ -18,6 +18,7 @@ package config
 import (
 	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/alibaba/ioc-golang/logger"
 )
@@ -27,6 +28,44 @@ const (
 	maxMergeDepth     uint8 = 1 << 4
 )
 
+// ListMergeStrategy determines how list in src config is merged into the list with the same key in dst config
+type ListMergeStrategy string
+
+const (
+	// ListMergeReplace replaces dst list with src list, which is the default strategy
+	ListMergeReplace ListMergeStrategy = "replace"
+	// ListMergeAppend appends src list items to dst list
+	ListMergeAppend ListMergeStrategy = "append"
+	// ListMergeByKey merges src map item into dst map item with the same merge key value, like 'name', and appends
+	// others. Scalar items that already exist in dst list are ignored.
+	ListMergeByKey ListMergeStrategy = "merge-by-key"
+
+	DefaultListMergeKey = "name"
+
+	appendDirectiveSuffix = "+"
+	directivePrefix       = "("
+	directiveSuffix       = ")"
+	mergeKeySeparator     = "="
+)
+
+func (s ListMergeStrategy) isValid() bool {
+	switch s {
+	case ListMergeReplace, ListMergeAppend, ListMergeByKey:
+		return true
+	}
+	return false
+}
+
+// MergeOptions is options of MergeMapWithOptions
+type MergeOptions struct {
+	// ListStrategy is the default strategy of merging lists, default: replace
+	ListStrategy ListMergeStrategy
+	// ListMergeKey is the item key used by merge-by-key strategy, default: name
+	ListMergeKey string
+	// MaxDepth is the max depth of merging maps, default: 8
+	MaxDepth uint8
+}
+
 type AnyMap = map[string]interface{} // alias
 
 // MergeMap
@@ -35,11 +74,45 @@ type AnyMap = map[string]interface{} // alias
 //
 // @return dst
 func MergeMap(dst, src AnyMap, maxDepths ...uint8) AnyMap {
-	return merge(dst, src, 0, maxDepths...)
+	return MergeMapWithOptions(dst, src, MergeOptions{
+		MaxDepth: determineMerDepth(maxDepths),
+	})
 }
 
-func merge(dst, src AnyMap, depth uint8, depths ...uint8) AnyMap {
-	maxDepth := determineMerDepth(depths)
+/*
+MergeMapWithOptions merges src into dst with list merge strategy, strategy of one key can be set in src with
+directive, like:
+
+```yaml
+brokers+: [broker-3]                      # append
+brokers(append): [broker-3]               # append
+brokers(replace): [broker-3]              # replace, also works for map, which is replaced but not merged
+brokers(merge-by-key=id): [{id: 1, ...}]  # merge by item key 'id'
+brokers(merge-by-key): [{name: a, ...}]   # merge by options ListMergeKey
+```
+
+@dangerous trigger PANIC
+
+@return dst
+*/
+func MergeMapWithOptions(dst, src AnyMap, opts MergeOptions) AnyMap {
+	if opts.MaxDepth == 0 {
+		opts.MaxDepth = defaultMergeDepth
+	}
+	if opts.ListStrategy == "" {
+		opts.ListStrategy = ListMergeReplace
+	} else if !opts.ListStrategy.isValid() {
+		logger.Red("[Config] Invalid list merge strategy %s, fall back to %s", opts.ListStrategy, ListMergeReplace)
+		opts.ListStrategy = ListMergeReplace
+	}
+	if opts.ListMergeKey == "" {
+		opts.ListMergeKey = DefaultListMergeKey
+	}
+	return merge(dst, src, 0, opts)
+}
+
+func merge(dst, src AnyMap, depth uint8, opts MergeOptions) AnyMap {
+	maxDepth := opts.MaxDepth
 	if maxDepth > maxMergeDepth {
 		panic(fmt.Sprintf("[Config] expect depth too deep: [%d]", maxDepth))
 	}
@@ -48,14 +121,28 @@ func merge(dst, src AnyMap, depth uint8, depths ...uint8) AnyMap {
 		panic(fmt.Sprintf("[Config] recursion too deep: [%d]", depth))
 	}
 
-	for k, v := range src {
+	for rawKey, v := range src {
+		k, strategy, mergeKey, hasDirective := parseMergeDirective(rawKey)
+		if !hasDirective {
+			strategy, mergeKey = opts.ListStrategy, opts.ListMergeKey
+		} else if mergeKey == "" {
+			mergeKey = opts.ListMergeKey
+		}
+		merged := false
 		if dv, ok := dst[k]; ok {
 			dstMap, dstOk := toMap(dv)
 			srcMap, srcOk := toMap(v)
-			if srcOk && dstOk {
-				v = merge(dstMap, srcMap, depth+1, depths...)
+			dstList, dstListOk := dv.([]interface{})
+			srcList, srcListOk := v.([]interface{})
+			if srcOk && dstOk && !(hasDirective && strategy == ListMergeReplace) {
+				v, merged = merge(dstMap, srcMap, depth+1, opts), true
+			} else if srcListOk && dstListOk {
+				v, merged = mergeList(dstList, srcList, strategy, mergeKey, depth, opts), true
 			}
 		}
+		if !merged {
+			v = stripMergeDirectives(v)
+		}
 
 		dst[k] = v
 	}
@@ -63,6 +150,85 @@ func merge(dst, src AnyMap, depth uint8, depths ...uint8) AnyMap {
 	return dst
 }
 
+func mergeList(dst, src []interface{}, strategy ListMergeStrategy, mergeKey string, depth uint8, opts MergeOptions) []interface{} {
+	switch strategy {
+	case ListMergeAppend:
+		result := make([]interface{}, 0, len(dst)+len(src))
+		result = append(result, dst...)
+		return append(result, stripMergeDirectives(src).([]interface{})...)
+	case ListMergeByKey:
+		result := make([]interface{}, 0, len(dst)+len(src))
+		result = append(result, dst...)
+	SRC:
+		for _, srcItem := range src {
+			srcItemMap, srcItemOk := toMap(srcItem)
+			for i, dstItem := range result {
+				if srcItemOk {
+					dstItemMap, dstItemOk := toMap(dstItem)
+					srcKeyValue, srcKeyOk := srcItemMap[mergeKey]
+					if dstItemOk && srcKeyOk && reflect.DeepEqual(dstItemMap[mergeKey], srcKeyValue) {
+						result[i] = merge(dstItemMap, srcItemMap, depth+1, opts)
+						continue SRC
+					}
+				} else if reflect.DeepEqual(dstItem, srcItem) {
+					continue SRC
+				}
+			}
+			result = append(result, stripMergeDirectives(srcItem))
+		}
+		return result
+	}
+	return stripMergeDirectives(src).([]interface{})
+}
+
+// parseMergeDirective parses key like 'brokers+', 'brokers(append)', 'brokers(merge-by-key=id)'
+func parseMergeDirective(rawKey string) (string, ListMergeStrategy, string, bool) {
+	if strings.HasSuffix(rawKey, appendDirectiveSuffix) && len(rawKey) > len(appendDirectiveSuffix) {
+		return strings.TrimSuffix(rawKey, appendDirectiveSuffix), ListMergeAppend, "", true
+	}
+	if !strings.HasSuffix(rawKey, directiveSuffix) {
+		return rawKey, "", "", false
+	}
+	idx := strings.LastIndex(rawKey, directivePrefix)
+	if idx <= 0 {
+		return rawKey, "", "", false
+	}
+	directive := rawKey[idx+len(directivePrefix) : len(rawKey)-len(directiveSuffix)]
+	mergeKey := ""
+	if splited := strings.SplitN(directive, mergeKeySeparator, 2); len(splited) == 2 {
+		directive, mergeKey = splited[0], strings.TrimSpace(splited[1])
+	}
+	if strategy := ListMergeStrategy(strings.TrimSpace(directive)); strategy.isValid() {
+		return rawKey[:idx], strategy, mergeKey, true
+	}
+	return rawKey, "", "", false
+}
+
+// stripMergeDirectives removes merge directives of keys in maps that are not merged with dst
+func stripMergeDirectives(v interface{}) interface{} {
+	switch val := v.(type) {
+	case Config:
+		stripMapMergeDirectives(val)
+	case AnyMap:
+		stripMapMergeDirectives(val)
+	case []interface{}:
+		for i, item := range val {
+			val[i] = stripMergeDirectives(item)
+		}
+	}
+	return v
+}
+
+func stripMapMergeDirectives(m map[string]interface{}) {
+	for rawKey, v := range m {
+		v = stripMergeDirectives(v)
+		if k, _, _, ok := parseMergeDirective(rawKey); ok {
+			delete(m, rawKey)
+			m[k] = v
+		}
+	}
+}
+
 func determineMerDepth(depths []uint8) uint8 {
 	depth := defaultMergeDepth
 	switch len(depths) {
@@ -74,6 +240,12 @@ func determineMerDepth(depths []uint8) uint8 {
 }
 
 func toMap(src interface{}) (AnyMap, bool) {
+	switch val := src.(type) {
+	case Config:
+		return val, true
+	case AnyMap:
+		return val, true
+	}
 	value := reflect.ValueOf(src)
 	if value.Kind() == reflect.Map {
 		am := AnyMap{}
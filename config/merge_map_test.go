@@ -17,7 +17,11 @@ package config
 
 import (
 	"fmt"
+	"os"
+	"strings"
 	"testing"
+
+	"github.com/stretchr/testify/assert"
 )
 
 func TestMergeMap(t *testing.T) {
@@ -248,6 +252,143 @@ func TestMergeMap(t *testing.T) {
 	}
 }
 
+func TestMergeMapWithOptions(t *testing.T) {
+	newDst := func() AnyMap {
+		return AnyMap{
+			"brokers": []interface{}{"broker-1", "broker-2"},
+			"servers": []interface{}{
+				AnyMap{"name": "a", "port": 1, "weight": 1},
+				AnyMap{"name": "b", "port": 2},
+			},
+			"sub": AnyMap{
+				"mapKey1": "mapValue1",
+				"mapKey2": "mapValue2",
+			},
+		}
+	}
+	tests := []struct {
+		name string
+		opts MergeOptions
+		src  AnyMap
+		want AnyMap
+	}{
+		{
+			name: "Test MergeMapWithOptions()-default replace",
+			src: AnyMap{
+				"brokers": []interface{}{"broker-3"},
+			},
+			want: AnyMap{
+				"brokers": []interface{}{"broker-3"},
+			},
+		},
+		{
+			name: "Test MergeMapWithOptions()-global append",
+			opts: MergeOptions{ListStrategy: ListMergeAppend},
+			src: AnyMap{
+				"brokers": []interface{}{"broker-3"},
+			},
+			want: AnyMap{
+				"brokers": []interface{}{"broker-1", "broker-2", "broker-3"},
+			},
+		},
+		{
+			name: "Test MergeMapWithOptions()-global merge by key",
+			opts: MergeOptions{ListStrategy: ListMergeByKey},
+			src: AnyMap{
+				"brokers": []interface{}{"broker-2", "broker-3"},
+				"servers": []interface{}{
+					AnyMap{"name": "a", "port": 3},
+					AnyMap{"name": "c", "port": 4},
+				},
+			},
+			want: AnyMap{
+				"brokers": []interface{}{"broker-1", "broker-2", "broker-3"},
+				"servers": []interface{}{
+					AnyMap{"name": "a", "port": 3, "weight": 1},
+					AnyMap{"name": "b", "port": 2},
+					AnyMap{"name": "c", "port": 4},
+				},
+			},
+		},
+		{
+			name: "Test MergeMapWithOptions()-append directive",
+			src: AnyMap{
+				"brokers+": []interface{}{"broker-3"},
+			},
+			want: AnyMap{
+				"brokers": []interface{}{"broker-1", "broker-2", "broker-3"},
+			},
+		},
+		{
+			name: "Test MergeMapWithOptions()-replace directive overrides global strategy",
+			opts: MergeOptions{ListStrategy: ListMergeAppend},
+			src: AnyMap{
+				"brokers(replace)": []interface{}{"broker-3"},
+			},
+			want: AnyMap{
+				"brokers": []interface{}{"broker-3"},
+			},
+		},
+		{
+			name: "Test MergeMapWithOptions()-merge by key directive with custom key",
+			src: AnyMap{
+				"servers(merge-by-key=port)": []interface{}{
+					AnyMap{"name": "c", "port": 2},
+				},
+			},
+			want: AnyMap{
+				"servers": []interface{}{
+					AnyMap{"name": "a", "port": 1, "weight": 1},
+					AnyMap{"name": "c", "port": 2},
+				},
+			},
+		},
+		{
+			name: "Test MergeMapWithOptions()-replace directive on map",
+			src: AnyMap{
+				"sub(replace)": AnyMap{
+					"mapKey3": "mapValue3",
+				},
+			},
+			want: AnyMap{
+				"sub": AnyMap{
+					"mapKey3": "mapValue3",
+				},
+			},
+		},
+		{
+			name: "Test MergeMapWithOptions()-directive in new sub map",
+			src: AnyMap{
+				"new": AnyMap{
+					"list+": []interface{}{"item"},
+				},
+			},
+			want: AnyMap{
+				"new": AnyMap{
+					"list": []interface{}{"item"},
+				},
+			},
+		},
+		{
+			name: "Test MergeMapWithOptions()-unknown directive is kept as key",
+			src: AnyMap{
+				"brokers(unknown)": []interface{}{"broker-3"},
+			},
+			want: AnyMap{
+				"brokers(unknown)": []interface{}{"broker-3"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			am := MergeMapWithOptions(newDst(), tt.src, tt.opts)
+			for k, v := range tt.want {
+				assert.Equal(t, v, am[k])
+			}
+		})
+	}
+}
+
 var depth16 = AnyMap{
 	"Java":  1995,
 	"Go":    2009,
@@ -391,3 +532,54 @@ var depth17 = AnyMap{
 		},
 	},
 }
+
+func TestLoad_listMerge(t *testing.T) {
+	t.Run("test load with append directive", func(t *testing.T) {
+		assert.Nil(t, Load(
+			WithConfigName("ioc_golang"),
+			WithSearchPath("./test"),
+			WithProfilesActive("merge"),
+		))
+		sliceValue := make([]string, 0)
+		assert.Nil(t, LoadConfigByPrefix("autowire.config.sliceValue", &sliceValue))
+		assert.Equal(t, []string{"sliceStr1", "sliceStr2", "sliceStr3", "sliceStr4"}, sliceValue)
+	})
+
+	t.Run("test load with global merge by key strategy", func(t *testing.T) {
+		assert.Nil(t, Load(
+			WithConfigName("ioc_golang"),
+			WithSearchPath("./test"),
+			WithProfilesActive("dev"),
+			WithListMergeStrategy(ListMergeByKey),
+		))
+		sliceValue := make([]string, 0)
+		assert.Nil(t, LoadConfigByPrefix("profilesActive.shared.sliceValue", &sliceValue))
+		assert.Equal(t, []string{"sliceStr1", "sliceStr2", "sliceStr3"}, sliceValue)
+	})
+
+	t.Run("test load with invalid strategy from env", func(t *testing.T) {
+		defer clearEnv()
+		assert.Nil(t, os.Setenv(ListMergeEnvKey, "apend"))
+		err := Load(
+			WithConfigName("ioc_golang"),
+			WithSearchPath("./test"),
+		)
+		assert.NotNil(t, err)
+		assert.True(t, strings.Contains(err.Error(), "apend"))
+
+		assert.Nil(t, os.Setenv(ListMergeEnvKey, " append "))
+		assert.Nil(t, Load(
+			WithConfigName("ioc_golang"),
+			WithSearchPath("./test"),
+			WithProfilesActive("dev"),
+		))
+	})
+
+	t.Run("test load with invalid strategy option", func(t *testing.T) {
+		assert.NotNil(t, Load(
+			WithConfigName("ioc_golang"),
+			WithSearchPath("./test"),
+			WithListMergeStrategy("merge"),
+		))
+	})
+}
@@ -0,0 +1,279 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"strings"
+
+	perrors "github.com/pkg/errors"
+)
+
+const (
+	// ProfilesGateKey is the key of yaml document that gates the document with profile expressions, like:
+	//
+	// ioc-golang:
+	//   profiles: [dev, cloud & !local]
+	//
+	// document is merged if any of the expressions matches active profiles
+	ProfilesGateKey = "ioc-golang.profiles"
+
+	profileNotOperator = '!'
+	profileAndOperator = '&'
+	profileOrOperator  = '|'
+)
+
+// ProfileExpression is parsed profile expression like 'dev', '!prod', 'dev & cloud', 'dev | (test & !local)'
+type ProfileExpression struct {
+	expr string
+	root profileNode
+}
+
+// ProfileSpecificity describes how specific a matched profile expression is, which is used to select the best one
+// among multiple matched expressions
+type ProfileSpecificity struct {
+	// Profiles is count of active profiles required by the expression, like 2 of 'dev & cloud'
+	Profiles int
+	// LastIndex is the max index in active profiles of required profiles, later active profile has higher priority,
+	// -1 if no profile is required
+	LastIndex int
+	// Negations is count of excluded profiles, like 1 of 'cloud & !local'
+	Negations int
+}
+
+// Compare returns positive if s is more specific than other, negative if less, and 0 if they are equally specific
+func (s ProfileSpecificity) Compare(other ProfileSpecificity) int {
+	if s.Profiles != other.Profiles {
+		return s.Profiles - other.Profiles
+	}
+	if s.LastIndex != other.LastIndex {
+		return s.LastIndex - other.LastIndex
+	}
+	return s.Negations - other.Negations
+}
+
+type profileNode interface {
+	// specificity returns specificity and if the node matches active profiles, active profiles is map from profile
+	// to its index
+	specificity(activeProfiles map[string]int) (ProfileSpecificity, bool)
+}
+
+type profileNameNode string
+
+func (n profileNameNode) specificity(activeProfiles map[string]int) (ProfileSpecificity, bool) {
+	index, ok := activeProfiles[string(n)]
+	if !ok {
+		return ProfileSpecificity{}, false
+	}
+	return ProfileSpecificity{Profiles: 1, LastIndex: index}, true
+}
+
+type profileNotNode struct {
+	sub profileNode
+}
+
+func (n *profileNotNode) specificity(activeProfiles map[string]int) (ProfileSpecificity, bool) {
+	if _, ok := n.sub.specificity(activeProfiles); ok {
+		return ProfileSpecificity{}, false
+	}
+	return ProfileSpecificity{LastIndex: -1, Negations: 1}, true
+}
+
+type profileAndNode struct {
+	subs []profileNode
+}
+
+func (n *profileAndNode) specificity(activeProfiles map[string]int) (ProfileSpecificity, bool) {
+	result := ProfileSpecificity{LastIndex: -1}
+	for _, sub := range n.subs {
+		subSpecificity, ok := sub.specificity(activeProfiles)
+		if !ok {
+			return ProfileSpecificity{}, false
+		}
+		result.Profiles += subSpecificity.Profiles
+		result.Negations += subSpecificity.Negations
+		if subSpecificity.LastIndex > result.LastIndex {
+			result.LastIndex = subSpecificity.LastIndex
+		}
+	}
+	return result, true
+}
+
+type profileOrNode struct {
+	subs []profileNode
+}
+
+func (n *profileOrNode) specificity(activeProfiles map[string]int) (ProfileSpecificity, bool) {
+	var result ProfileSpecificity
+	matched := false
+	for _, sub := range n.subs {
+		subSpecificity, ok := sub.specificity(activeProfiles)
+		if ok && (!matched || subSpecificity.Compare(result) > 0) {
+			result, matched = subSpecificity, true
+		}
+	}
+	return result, matched
+}
+
+// ParseProfileExpression parses profile expression, '!' has the highest priority, then '&', then '|', parentheses
+// can be used to change priority.
+func ParseProfileExpression(expr string) (*ProfileExpression, error) {
+	parser := &profileExpressionParser{
+		expr: expr,
+	}
+	root, err := parser.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if parser.skipSpaces(); parser.pos < len(expr) {
+		return nil, perrors.Errorf("invalid profile expression '%s', unexpected '%c' at %d", expr, expr[parser.pos], parser.pos)
+	}
+	return &ProfileExpression{
+		expr: expr,
+		root: root,
+	}, nil
+}
+
+// Matches returns if the expression matches active profiles
+func (p *ProfileExpression) Matches(activeProfiles []string) bool {
+	_, ok := p.Specificity(activeProfiles)
+	return ok
+}
+
+// Specificity returns specificity of the expression and if the expression matches active profiles
+func (p *ProfileExpression) Specificity(activeProfiles []string) (ProfileSpecificity, bool) {
+	activeProfilesMap := make(map[string]int, len(activeProfiles))
+	for i, profile := range activeProfiles {
+		activeProfilesMap[profile] = i
+	}
+	return p.root.specificity(activeProfilesMap)
+}
+
+func (p *ProfileExpression) String() string {
+	return p.expr
+}
+
+// MatchProfiles returns if any of profile expressions matches active profiles
+func MatchProfiles(exprs []string, activeProfiles []string) (bool, error) {
+	for _, expr := range exprs {
+		profileExpression, err := ParseProfileExpression(expr)
+		if err != nil {
+			return false, err
+		}
+		if profileExpression.Matches(activeProfiles) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type profileExpressionParser struct {
+	expr string
+	pos  int
+}
+
+func (p *profileExpressionParser) skipSpaces() {
+	for p.pos < len(p.expr) && (p.expr[p.pos] == ' ' || p.expr[p.pos] == '\t') {
+		p.pos++
+	}
+}
+
+func (p *profileExpressionParser) consume(operator byte) bool {
+	p.skipSpaces()
+	if p.pos < len(p.expr) && p.expr[p.pos] == operator {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *profileExpressionParser) parseOr() (profileNode, error) {
+	subs := make([]profileNode, 0)
+	for {
+		sub, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+		if !p.consume(profileOrOperator) {
+			break
+		}
+	}
+	if len(subs) == 1 {
+		return subs[0], nil
+	}
+	return &profileOrNode{subs: subs}, nil
+}
+
+func (p *profileExpressionParser) parseAnd() (profileNode, error) {
+	subs := make([]profileNode, 0)
+	for {
+		sub, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		subs = append(subs, sub)
+		if !p.consume(profileAndOperator) {
+			break
+		}
+	}
+	if len(subs) == 1 {
+		return subs[0], nil
+	}
+	return &profileAndNode{subs: subs}, nil
+}
+
+func (p *profileExpressionParser) parseUnary() (profileNode, error) {
+	if p.consume(profileNotOperator) {
+		sub, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &profileNotNode{sub: sub}, nil
+	}
+	if p.consume('(') {
+		sub, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.consume(')') {
+			return nil, perrors.Errorf("invalid profile expression '%s', missing ')' at %d", p.expr, p.pos)
+		}
+		return sub, nil
+	}
+	p.skipSpaces()
+	start := p.pos
+	for p.pos < len(p.expr) && !strings.ContainsRune(" \t!&|()", rune(p.expr[p.pos])) {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, perrors.Errorf("invalid profile expression '%s', expect profile name at %d", p.expr, p.pos)
+	}
+	return profileNameNode(p.expr[start:p.pos]), nil
+}
+
+// popProfilesGate removes ProfilesGateKey from yaml document, and returns profile expressions of it, nil is returned
+// if the document is not gated
+func popProfilesGate(document Config) ([]string, error) {
+	gate, ok := popConfigKey(document, ProfilesGateKey)
+	if !ok {
+		return nil, nil
+	}
+	exprs, ok := toStringSlice(gate)
+	if !ok {
+		return nil, perrors.Errorf("%s should be string or list of profile expressions, but got %v", ProfilesGateKey, gate)
+	}
+	return exprs, nil
+}
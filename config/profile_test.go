@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProfileExpression(t *testing.T) {
+	tests := []struct {
+		expr           string
+		activeProfiles []string
+		want           bool
+		wantErr        bool
+	}{
+		{expr: "dev", activeProfiles: []string{"dev"}, want: true},
+		{expr: "dev", activeProfiles: []string{"prod"}, want: false},
+		{expr: "!prod", activeProfiles: []string{"dev"}, want: true},
+		{expr: "!prod", activeProfiles: []string{"prod"}, want: false},
+		{expr: "dev & cloud", activeProfiles: []string{"dev"}, want: false},
+		{expr: "dev & cloud", activeProfiles: []string{"cloud", "dev"}, want: true},
+		{expr: "dev|test", activeProfiles: []string{"test"}, want: true},
+		{expr: "cloud & !local", activeProfiles: []string{"cloud", "local"}, want: false},
+		{expr: "dev | test & cloud", activeProfiles: []string{"dev"}, want: true},
+		{expr: "(dev | test) & cloud", activeProfiles: []string{"dev"}, want: false},
+		{expr: "!!dev", activeProfiles: []string{"dev"}, want: true},
+		{expr: "", wantErr: true},
+		{expr: "dev &", wantErr: true},
+		{expr: "(dev | test", wantErr: true},
+		{expr: "dev)", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			profileExpression, err := ParseProfileExpression(tt.expr)
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, tt.want, profileExpression.Matches(tt.activeProfiles))
+		})
+	}
+}
+
+func TestLoad_multiDocument(t *testing.T) {
+	configFile, _ := filepath.Abs("./test/ioc_golang_multi_document.yaml")
+	type app struct {
+		Name    string
+		Address string
+		Brokers []string
+		Debug   bool
+	}
+	tests := []struct {
+		name           string
+		activeProfiles []string
+		want           app
+	}{
+		{
+			name: "test load without active profile",
+			want: app{Name: "multi-document", Address: "localhost:8080", Brokers: []string{"broker-1"}, Debug: true},
+		},
+		{
+			name:           "test load with dev profile",
+			activeProfiles: []string{"dev"},
+			want:           app{Name: "multi-document", Address: "localhost:8081", Brokers: []string{"broker-1"}, Debug: true},
+		},
+		{
+			name:           "test load with dev and cloud profiles",
+			activeProfiles: []string{"dev", "cloud"},
+			want:           app{Name: "multi-document", Address: "localhost:8081", Brokers: []string{"broker-1", "broker-2"}, Debug: true},
+		},
+		{
+			name:           "test load with prod profile",
+			activeProfiles: []string{"prod"},
+			want:           app{Name: "multi-document", Address: "prod:80", Brokers: []string{"broker-1"}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Nil(t, Load(WithAbsPath(configFile), WithProfilesActive(tt.activeProfiles...)))
+			assert.Equal(t, tt.activeProfiles, GetActiveProfiles())
+			got := app{}
+			assert.Nil(t, LoadConfigByPrefix("app", &got))
+			assert.Equal(t, tt.want, got)
+			_, err := GetProperties("ioc-golang.profiles")
+			assert.NotNil(t, err)
+		})
+	}
+
+	t.Run("test load with invalid profile expression", func(t *testing.T) {
+		invalidFile := filepath.Join(t.TempDir(), "config.yaml")
+		assert.Nil(t, os.WriteFile(invalidFile, []byte("ioc-golang:\n  profiles: [\"dev &\"]\n"), 0600))
+		assert.NotNil(t, Load(WithAbsPath(invalidFile)))
+	})
+}
@@ -2,14 +2,20 @@ package config
 
 import (
 	"regexp"
+	"strings"
 )
 
+var envKeyPattern = regexp.MustCompile("^[A-Z_][A-Z0-9_]*$")
+
 func isEnv(envValue string) bool {
 	// ${ Xxx_Yyy_Zzz }
-	ok, err := regexp.Match("^\\$\\{[A-Z_]+}$", []byte(envValue))
-	if err != nil || !ok {
+	if !strings.HasPrefix(envValue, EnvPrefixKey) || !strings.HasSuffix(envValue, EnvSuffixKey) {
 		return false
 	}
+	return isEnvKey(envValue[len(EnvPrefixKey) : len(envValue)-len(EnvSuffixKey)])
+}
 
-	return true
+// isEnvKey returns if placeholder key is env key like 'REDIS_ADDRESS', otherwise it's config path
+func isEnvKey(key string) bool {
+	return envKeyPattern.MatchString(key)
 }
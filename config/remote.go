@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"sync"
+
+	perrors "github.com/pkg/errors"
+
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// RemoteDocument is yaml document loaded from remote source, like config center
+type RemoteDocument struct {
+	// Name is unique name of document, which is used as origin of config values, like 'nacos:DEFAULT_GROUP/app.yaml'
+	Name     string
+	Contents []byte
+}
+
+// RemoteSource loads config documents from remote, like config center. Documents are merged in order after local
+// config files, and before properties set by API and args.
+type RemoteSource interface {
+	// Load loads documents, bootstrap is config merged from local files, from which source can read its own
+	// connection config. Empty documents should be returned if source is not enabled by bootstrap config.
+	Load(bootstrap Config) ([]*RemoteDocument, error)
+	// Watch watches changes of loaded documents, onChange should be called with new document if any of them changes.
+	// Watch is called after each Load, and previous watching should be stopped.
+	Watch(onChange func(document *RemoteDocument)) error
+}
+
+var (
+	remoteSources     = make([]RemoteSource, 0)
+	remoteSourcesLock sync.RWMutex
+
+	// lastRemoteDocuments and lastOptions are used to rebuild config when remote document changes
+	lastRemoteDocuments []*RemoteDocument
+	lastOptions         *Options
+)
+
+// RegisterRemoteSource registers remote source used by all Load calls, like nacos.NewBootstrapConfigSource() of
+// config center extension, before ioc.Load is called
+func RegisterRemoteSource(source RemoteSource) {
+	remoteSourcesLock.Lock()
+	defer remoteSourcesLock.Unlock()
+	remoteSources = append(remoteSources, source)
+}
+
+func getRemoteSources(options *Options) []RemoteSource {
+	remoteSourcesLock.RLock()
+	defer remoteSourcesLock.RUnlock()
+	return append(append(make([]RemoteSource, 0, len(remoteSources)+len(options.RemoteSources)), remoteSources...), options.RemoteSources...)
+}
+
+func loadRemoteDocuments(bootstrap Config, options *Options) ([]*RemoteDocument, error) {
+	documents := make([]*RemoteDocument, 0)
+	for _, source := range getRemoteSources(options) {
+		sourceDocuments, err := source.Load(bootstrap)
+		if err != nil {
+			return nil, perrors.Errorf("[Config] Load remote config failed, error = %s", err)
+		}
+		documents = append(documents, sourceDocuments...)
+	}
+	return documents, nil
+}
+
+func mergeRemoteDocuments(targetMap Config, documents []*RemoteDocument, options *Options) (Config, error) {
+	var err error
+	for _, document := range documents {
+		logger.Blue("[Config] Loading remote config %s", document.Name)
+		if targetMap, err = mergeConfigDocuments(targetMap, document.Contents, []string{document.Name}, options); err != nil {
+			logger.Red("[Config] Load remote config %s failed, err: %v", document.Name, err)
+			return nil, err
+		}
+	}
+	return targetMap, nil
+}
+
+func watchRemoteSources(documents []*RemoteDocument, options *Options) error {
+	lastRemoteDocuments = documents
+	lastOptions = options
+	for _, source := range getRemoteSources(options) {
+		if err := source.Watch(onRemoteDocumentChanged); err != nil {
+			return perrors.Errorf("[Config] Watch remote config failed, error = %s", err)
+		}
+	}
+	return nil
+}
+
+// onRemoteDocumentChanged rebuilds config with changed remote document, and notifies listeners of changed keys
+func onRemoteDocumentChanged(document *RemoteDocument) {
+	// listeners are notified without lock, so that they can load config
+	notifyListeners(reloadWithRemoteDocument(document))
+}
+
+func reloadWithRemoteDocument(document *RemoteDocument) []*ChangeEvent {
+	loadLock.Lock()
+	defer loadLock.Unlock()
+	logger.Blue("[Config] Remote config %s changed, reloading", document.Name)
+	documents := make([]*RemoteDocument, 0, len(lastRemoteDocuments)+1)
+	replaced := false
+	for _, lastDocument := range lastRemoteDocuments {
+		if lastDocument.Name == document.Name {
+			lastDocument, replaced = document, true
+		}
+		documents = append(documents, lastDocument)
+	}
+	if !replaced {
+		// document not loaded before, like optional document created after loading
+		documents = append(documents, document)
+	}
+
+	resetLoadingOrigins()
+	targetMap, err := loadConfigFiles(lastOptions)
+	if err == nil && targetMap != nil {
+		targetMap, err = mergeRemoteDocuments(targetMap, documents, lastOptions)
+	}
+	if err != nil || targetMap == nil {
+		logger.Red("[Config] Reload config with remote config %s failed, error = %v", document.Name, err)
+		return nil
+	}
+	addProperties(targetMap, lastOptions.Properties, OriginAPI)
+	addProperties(targetMap, lastOptions.ArgProperties, OriginArgs)
+	if err := parseConfigIfNecessary(targetMap, lastOptions); err != nil {
+		logger.Red("[Config] Reload config with remote config %s failed, error = %v", document.Name, err)
+		return nil
+	}
+	lastRemoteDocuments = documents
+	oldConfig := getConfig()
+	setConfig(targetMap)
+	commitLoadingOrigins()
+	return diffConfig(oldConfig, targetMap)
+}
@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockRemoteSource struct {
+	documents []*RemoteDocument
+	onChange  func(document *RemoteDocument)
+}
+
+func (m *mockRemoteSource) Load(bootstrap Config) ([]*RemoteDocument, error) {
+	return m.documents, nil
+}
+
+func (m *mockRemoteSource) Watch(onChange func(document *RemoteDocument)) error {
+	m.onChange = onChange
+	return nil
+}
+
+func TestLoad_remoteSource(t *testing.T) {
+	source := &mockRemoteSource{
+		documents: []*RemoteDocument{
+			{Name: "mock:app.yaml", Contents: []byte("app:\n  name: remote\n  address: localhost:8080\n")},
+			{Name: "mock:redis.yaml", Contents: []byte("redis:\n  address: localhost:6379\n")},
+		},
+	}
+	assert.Nil(t, Load(
+		WithRemoteSource(source),
+		AddProperty("app.name", "api"),
+	))
+	address := ""
+	assert.Nil(t, LoadConfigByPrefix("app.address", &address))
+	assert.Equal(t, "localhost:8080", address)
+	name := ""
+	assert.Nil(t, LoadConfigByPrefix("app.name", &name))
+	assert.Equal(t, "api", name)
+	assert.Equal(t, "mock:redis.yaml:2", GetOrigin("redis.address"))
+
+	appEvents := make([]*ChangeEvent, 0)
+	removeAppListener := AddChangeListener("app", func(events []*ChangeEvent) {
+		appEvents = append(appEvents, events...)
+	})
+	defer removeAppListener()
+	allEvents := make([]*ChangeEvent, 0)
+	removeAllListener := AddChangeListener("", func(events []*ChangeEvent) {
+		allEvents = append(allEvents, events...)
+	})
+
+	source.onChange(&RemoteDocument{Name: "mock:app.yaml", Contents: []byte("app:\n  name: changed\n  address: localhost:8081\n  debug: true\n")})
+	assert.Nil(t, LoadConfigByPrefix("app.address", &address))
+	assert.Equal(t, "localhost:8081", address)
+	assert.Nil(t, LoadConfigByPrefix("app.name", &name))
+	assert.Equal(t, "api", name)
+	assert.Equal(t, []*ChangeEvent{
+		{Path: "app.address", Type: ChangeTypeModified, OldValue: "localhost:8080", NewValue: "localhost:8081"},
+		{Path: "app.debug", Type: ChangeTypeAdded, NewValue: true},
+	}, appEvents)
+	assert.Equal(t, appEvents, allEvents)
+
+	source.onChange(&RemoteDocument{Name: "mock:redis.yaml", Contents: []byte("redis: [")})
+	assert.Equal(t, "mock:redis.yaml:2", GetOrigin("redis.address"))
+	assert.Equal(t, "mock:app.yaml:3", GetOrigin("app.address"))
+	assert.Equal(t, 2, len(allEvents))
+
+	removeAllListener()
+	source.onChange(&RemoteDocument{Name: "mock:redis.yaml", Contents: []byte("")})
+	_, err := GetProperties("redis")
+	assert.NotNil(t, err)
+	assert.Equal(t, 2, len(allEvents))
+	assert.Equal(t, 2, len(appEvents))
+}
@@ -0,0 +1,249 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	perrors "github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	OriginAPI  = "api"
+	OriginArgs = "args"
+	OriginEnv  = "env"
+)
+
+// SensitiveKeyPattern matches config key whose value should be masked when config is dumped
+var SensitiveKeyPattern = regexp.MustCompile(`(?i)(password|passwd|secret|token|credential|private-?key|access-?key)`)
+
+var (
+	// origins records where config value of path comes from, like 'config/config.yaml:12', 'env:REDIS_ADDRESS', 'api'
+	origins = make(map[string]string)
+	// loadingOrigins records origins of config being built under loadLock, it replaces origins only after the config
+	// is built successfully, so that origins of live config are kept if loading fails
+	loadingOrigins = make(map[string]string)
+	originsLock    sync.RWMutex
+)
+
+// Property is one leaf of the effective config tree
+type Property struct {
+	// Path is like 'autowire.normal.<github.com/alibaba/ioc-golang/extension/state/redis.Redis>.param.address'
+	Path string
+	// Value is yaml scalar or json encoded list
+	Value  string
+	Origin string
+	Masked bool
+}
+
+func resetLoadingOrigins() {
+	originsLock.Lock()
+	defer originsLock.Unlock()
+	loadingOrigins = make(map[string]string)
+}
+
+// commitLoadingOrigins replaces origins with origins recorded by loading, it is called with config being stored
+func commitLoadingOrigins() {
+	originsLock.Lock()
+	defer originsLock.Unlock()
+	origins = loadingOrigins
+	loadingOrigins = make(map[string]string)
+}
+
+// recordOrigin records origin of path to the config being loaded, and removes origins of all sub paths, which are
+// overwritten
+func recordOrigin(path, origin string) {
+	originsLock.Lock()
+	defer originsLock.Unlock()
+	for recordedPath := range loadingOrigins {
+		if isSubPath(recordedPath, path) {
+			delete(loadingOrigins, recordedPath)
+		}
+	}
+	loadingOrigins[path] = origin
+}
+
+// GetOrigin returns where config value of path comes from, origin of the nearest parent path is returned if value
+// of path is not directly set.
+func GetOrigin(path string) string {
+	originsLock.RLock()
+	defer originsLock.RUnlock()
+	return getOriginFrom(origins, path)
+}
+
+// getLoadingOrigin is like GetOrigin, but returns origin of the config being loaded
+func getLoadingOrigin(path string) string {
+	originsLock.RLock()
+	defer originsLock.RUnlock()
+	return getOriginFrom(loadingOrigins, path)
+}
+
+func getOriginFrom(origins map[string]string, path string) string {
+	if origin, ok := origins[path]; ok {
+		return origin
+	}
+	cuts := getConfigPathCuts(path)
+	for i := len(cuts) - 1; i >= 0; i-- {
+		if origin, ok := origins[path[:cuts[i]]]; ok {
+			return origin
+		}
+	}
+	return ""
+}
+
+// getConfigPathCuts returns indexes of '.' and '[' that separate path units, separators inside '<>' are ignored
+func getConfigPathCuts(path string) []int {
+	cuts := make([]int, 0)
+	depth := 0
+	for i, c := range path {
+		switch c {
+		case '<':
+			depth++
+		case '>':
+			depth--
+		case '.', '[':
+			if depth == 0 && i > 0 {
+				cuts = append(cuts, i)
+			}
+		}
+	}
+	return cuts
+}
+
+func isSubPath(path, parent string) bool {
+	return strings.HasPrefix(path, parent+YamlConfigSeparator) || strings.HasPrefix(path, parent+"[")
+}
+
+// normalizeConfigPath converts property key set by api to path format used by origins
+func normalizeConfigPath(key string) string {
+	path := ""
+	for _, unit := range splitPrefix2Units(key) {
+		if unit != "" {
+			path = joinConfigPath(path, unit)
+		}
+	}
+	return path
+}
+
+// recordDocumentOrigins records line of all leaves in yaml document
+func recordDocumentOrigins(fileName string, document *yaml.Node) {
+	if len(document.Content) == 0 {
+		return
+	}
+	recordNodeOrigins(document.Content[0], "", fileName)
+}
+
+func recordNodeOrigins(node *yaml.Node, path, fileName string) {
+	if node.Kind != yaml.MappingNode {
+		if path != "" {
+			recordOrigin(path, fmt.Sprintf("%s:%d", fileName, node.Line))
+		}
+		return
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, _, _, _ := parseMergeDirective(node.Content[i].Value)
+		recordNodeOrigins(node.Content[i+1], joinConfigPath(path, key), fileName)
+	}
+}
+
+func isSensitivePath(path string) bool {
+	cuts := getConfigPathCuts(path)
+	for i := len(cuts) - 1; i >= 0 && path[cuts[i]] == '['; i-- {
+		// list item, check the key of list
+		path, cuts = path[:cuts[i]], cuts[:i]
+	}
+	key := path
+	if len(cuts) > 0 {
+		key = path[cuts[len(cuts)-1]+1:]
+	}
+	return SensitiveKeyPattern.MatchString(key)
+}
+
+// GetProperties returns all leaves of effective config tree under prefix, sorted by path, with origin of each leaf.
+// Values of sensitive keys and decrypted values are masked.
+func GetProperties(prefix string) ([]*Property, error) {
+	var subConfig interface{} = map[string]interface{}(getConfig())
+	path := ""
+	for _, unit := range splitPrefix2Units(prefix) {
+		if unit == "" {
+			continue
+		}
+		subMap, ok := toConfigMap(subConfig)
+		if !ok {
+			return nil, perrors.Errorf("property %s's key %s of config is not map", prefix, unit)
+		}
+		if subConfig, ok = subMap[unit]; !ok {
+			return nil, perrors.Errorf("property %s's key %s not found", prefix, unit)
+		}
+		path = joinConfigPath(path, unit)
+	}
+	properties := make([]*Property, 0)
+	collectProperties(subConfig, path, &properties)
+	sort.Slice(properties, func(i, j int) bool {
+		return properties[i].Path < properties[j].Path
+	})
+	return properties, nil
+}
+
+func collectProperties(value interface{}, path string, properties *[]*Property) {
+	if subMap, ok := toConfigMap(value); ok && len(subMap) > 0 {
+		for k, v := range subMap {
+			collectProperties(v, joinConfigPath(path, k), properties)
+		}
+		return
+	}
+	property := &Property{
+		Path:   path,
+		Value:  formatPropertyValue(value),
+		Origin: GetOrigin(path),
+	}
+	if isSensitivePath(path) || containsDecryptedPath(path) {
+		property.Value = MaskedValue
+		property.Masked = true
+	}
+	*properties = append(*properties, property)
+}
+
+func formatPropertyValue(value interface{}) string {
+	switch val := value.(type) {
+	case string:
+		return val
+	case nil:
+		return ""
+	case []interface{}, map[string]interface{}, Config:
+		data, err := json.Marshal(val)
+		if err == nil {
+			return string(data)
+		}
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+func toConfigMap(value interface{}) (map[string]interface{}, bool) {
+	switch val := value.(type) {
+	case Config:
+		return val, true
+	case AnyMap:
+		return val, true
+	}
+	return nil, false
+}
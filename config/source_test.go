@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetProperties(t *testing.T) {
+	defer clearEnv()
+	assert.Nil(t, os.Setenv("REDIS_ADDRESS_EXPAND", "localhost:6388"))
+	assert.Nil(t, Load(
+		WithConfigName("ioc_golang"),
+		WithSearchPath("./test"),
+		WithProfilesActive("dev"),
+		AddProperty("autowire.normal.<github.com/alibaba/ioc-golang/extension/state/redis.Redis>.param.password", "api-password"),
+		WithArgs([]string{"--ioc.autowire.config.intValue=456"}),
+	))
+	baseFile, _ := filepath.Abs("./test/ioc_golang.yaml")
+	devFile, _ := filepath.Abs("./test/ioc_golang_dev.yaml")
+	redisPrefix := "autowire.normal.<github.com/alibaba/ioc-golang/extension/state/redis.Redis>"
+
+	t.Run("test get properties with origin", func(t *testing.T) {
+		properties, err := GetProperties("autowire.config")
+		assert.Nil(t, err)
+		propertiesMap := make(map[string]*Property)
+		for _, p := range properties {
+			propertiesMap[p.Path] = p
+		}
+		assert.Equal(t, 6, len(properties))
+		assert.Equal(t, "autowire.config.intValue", properties[0].Path)
+		assert.Equal(t, "456", propertiesMap["autowire.config.intValue"].Value)
+		assert.Equal(t, OriginArgs, propertiesMap["autowire.config.intValue"].Origin)
+		assert.Equal(t, "strVal", propertiesMap["autowire.config.strValue"].Value)
+		assert.Equal(t, baseFile+":3", propertiesMap["autowire.config.strValue"].Origin)
+		assert.Equal(t, `["sliceStr1","sliceStr2","sliceStr3"]`, propertiesMap["autowire.config.sliceValue"].Value)
+		assert.Equal(t, baseFile+":10", propertiesMap["autowire.config.sliceValue"].Origin)
+	})
+
+	t.Run("test get properties with env, nested and masked", func(t *testing.T) {
+		properties, err := GetProperties(redisPrefix)
+		assert.Nil(t, err)
+		propertiesMap := make(map[string]*Property)
+		for _, p := range properties {
+			propertiesMap[p.Path] = p
+		}
+		assert.Equal(t, "localhost:6388", propertiesMap[redisPrefix+".expand.address"].Value)
+		assert.Equal(t, "env:REDIS_ADDRESS_EXPAND", propertiesMap[redisPrefix+".expand.address"].Origin)
+		assert.Equal(t, "localhost:6388", propertiesMap[redisPrefix+".nested.address"].Value)
+		assert.Equal(t, "${"+redisPrefix+".expand.address} -> env:REDIS_ADDRESS_EXPAND", propertiesMap[redisPrefix+".nested.address"].Origin)
+		assert.Equal(t, MaskedValue, propertiesMap[redisPrefix+".param.password"].Value)
+		assert.True(t, propertiesMap[redisPrefix+".param.password"].Masked)
+		assert.Equal(t, OriginAPI, propertiesMap[redisPrefix+".param.password"].Origin)
+	})
+
+	t.Run("test get properties from profile file", func(t *testing.T) {
+		properties, err := GetProperties("profilesActive.shared.boolValue")
+		assert.Nil(t, err)
+		assert.Equal(t, 1, len(properties))
+		assert.Equal(t, "true", properties[0].Value)
+		assert.Equal(t, devFile+":5", properties[0].Origin)
+	})
+
+	t.Run("test get properties not found", func(t *testing.T) {
+		_, err := GetProperties("autowire.notFound")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestIsSensitivePath(t *testing.T) {
+	assert.True(t, isSensitivePath("a.<github.com/xxx.Impl>.param.password"))
+	assert.True(t, isSensitivePath("a.accessKey[0]"))
+	assert.True(t, isSensitivePath("a.db-token"))
+	assert.False(t, isSensitivePath("a.<github.com/password.Impl>.param.address"))
+}
@@ -0,0 +1,150 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	ValidateTagKey = "validate"
+
+	ValidateModeEnvKey = "IOC_GOLANG_CONFIG_VALIDATE_MODE"
+)
+
+var (
+	validateMode bool
+
+	structValidator     *validator.Validate
+	structValidatorOnce sync.Once
+)
+
+// ValidationError describes one field of config struct that failed validation
+type ValidationError struct {
+	// Path is the full config path of the field, like 'autowire.normal.<github.com/xxx/xx/xxx.Impl>.param.address'
+	Path string
+	// Rule is the failed rule in validate tag, like 'required', 'min=1'
+	Rule  string
+	Value interface{}
+}
+
+func (e *ValidationError) Error() string {
+	return fmt.Sprintf("config %s failed on validate rule '%s', value = %v", e.Path, e.Rule, e.Value)
+}
+
+// ValidationErrors is the aggregation of all validation errors
+type ValidationErrors []*ValidationError
+
+func (e ValidationErrors) Error() string {
+	errMsgs := make([]string, 0, len(e))
+	for _, err := range e {
+		errMsgs = append(errMsgs, err.Error())
+	}
+	return fmt.Sprintf("[Config] %d config validation failed:\n%s", len(e), strings.Join(errMsgs, "\n"))
+}
+
+// IsValidationError returns if err is caused by config validation
+func IsValidationError(err error) bool {
+	validationErrors := ValidationErrors{}
+	return errors.As(err, &validationErrors)
+}
+
+// IsValidateMode returns if validate mode is active. In validate mode, all struct params are validated before
+// any struct is constructed, and all failures are aggregated and returned together.
+func IsValidateMode() bool {
+	return validateMode
+}
+
+// Validate checks configStructPtr loaded from config path prefix with 'validate' field tags like
+// `validate:"required,hostname_port"`, field path in returned ValidationErrors is named after prefix and yaml key
+// of field. configStructPtr that is not struct or struct ptr is ignored.
+func Validate(prefix string, configStructPtr interface{}) error {
+	if configStructPtr == nil {
+		return nil
+	}
+	value := reflect.ValueOf(configStructPtr)
+	for value.Kind() == reflect.Ptr || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return nil
+	}
+	err := getStructValidator().Struct(value.Interface())
+	if err == nil {
+		return nil
+	}
+	fieldErrors := validator.ValidationErrors{}
+	if !errors.As(err, &fieldErrors) {
+		return err
+	}
+	validationErrors := make(ValidationErrors, 0, len(fieldErrors))
+	for _, fieldError := range fieldErrors {
+		rule := fieldError.Tag()
+		if fieldError.Param() != "" {
+			rule = rule + "=" + fieldError.Param()
+		}
+		path := joinValidationPath(prefix, fieldError.Namespace())
+		value := fieldError.Value()
+		if containsDecryptedPath(path) {
+			value = MaskedValue
+		}
+		validationErrors = append(validationErrors, &ValidationError{
+			Path:  path,
+			Rule:  rule,
+			Value: value,
+		})
+	}
+	return validationErrors
+}
+
+func joinValidationPath(prefix, namespace string) string {
+	// namespace is like 'Param.address', trim the struct name
+	if idx := strings.Index(namespace, YamlConfigSeparator); idx >= 0 {
+		namespace = namespace[idx+1:]
+	}
+	if prefix == "" {
+		return namespace
+	}
+	return prefix + YamlConfigSeparator + namespace
+}
+
+func getStructValidator() *validator.Validate {
+	structValidatorOnce.Do(func() {
+		structValidator = validator.New()
+		structValidator.SetTagName(ValidateTagKey)
+		// name fields by yaml key, which is the same as config path
+		structValidator.RegisterTagNameFunc(func(field reflect.StructField) string {
+			name := strings.Split(field.Tag.Get("yaml"), ",")[0]
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				name = strings.ToLower(field.Name)
+			}
+			return name
+		})
+	})
+	return structValidator
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type validateParam struct {
+	Address string `validate:"required,hostname_port"`
+	DB      int    `yaml:"db-index" validate:"min=1"`
+	Sub     validateSubParam
+}
+
+type validateSubParam struct {
+	Timeout int `validate:"required"`
+}
+
+func TestValidate(t *testing.T) {
+	const prefix = "autowire.normal.<github.com/alibaba/ioc-golang/extension/state/redis.Redis>.param"
+	assert.Nil(t, Load(
+		AddProperty(prefix+".address", "localhost"),
+		AddProperty(prefix+".db-index", 0),
+	))
+
+	t.Run("test validate with all failures", func(t *testing.T) {
+		param := &validateParam{}
+		assert.Nil(t, LoadConfigByPrefix(prefix, param))
+		err := Validate(prefix, param)
+		assert.True(t, IsValidationError(err))
+		assert.True(t, IsValidationError(fmt.Errorf("wrapped: %w", err)))
+
+		validationErrors := err.(ValidationErrors)
+		assert.Equal(t, 3, len(validationErrors))
+		assert.Equal(t, prefix+".address", validationErrors[0].Path)
+		assert.Equal(t, "hostname_port", validationErrors[0].Rule)
+		assert.Equal(t, prefix+".db-index", validationErrors[1].Path)
+		assert.Equal(t, "min=1", validationErrors[1].Rule)
+		assert.Equal(t, prefix+".sub.timeout", validationErrors[2].Path)
+		assert.Equal(t, "required", validationErrors[2].Rule)
+	})
+
+	t.Run("test validate success", func(t *testing.T) {
+		param := &validateParam{
+			Address: "localhost:6379",
+			DB:      1,
+			Sub: validateSubParam{
+				Timeout: 1,
+			},
+		}
+		assert.Nil(t, Validate(prefix, param))
+	})
+
+	t.Run("test validate non struct", func(t *testing.T) {
+		intValue := 0
+		assert.Nil(t, Validate(prefix, &intValue))
+		assert.Nil(t, Validate(prefix, nil))
+		assert.False(t, IsValidationError(nil))
+	})
+}
+
+func TestWithValidateMode(t *testing.T) {
+	assert.Nil(t, Load(WithValidateMode()))
+	assert.True(t, IsValidateMode())
+	assert.Nil(t, Load())
+	assert.False(t, IsValidateMode())
+
+	assert.NotNil(t, Load(
+		WithValidateMode(),
+		WithProfilesActive("dev"),
+		WithRemoteSource(&mockRemoteSource{
+			documents: []*RemoteDocument{{Name: "mock:invalid.yaml", Contents: []byte("app: [")}},
+		}),
+	))
+	assert.False(t, IsValidateMode())
+	assert.Empty(t, GetActiveProfiles())
+}
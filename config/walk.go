@@ -0,0 +1,67 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+// walkConfigStringValues calls handler with full path of all string values in config, and sets value returned,
+// string values in list are also walked with path like 'a.b[0]'
+func walkConfigStringValues(config map[string]interface{}, prefix string, handler func(path, val string) (interface{}, error)) error {
+	for k, v := range config {
+		path := joinConfigPath(prefix, k)
+		newVal, err := walkValue(v, path, handler)
+		if err != nil {
+			return err
+		}
+		config[k] = newVal
+	}
+	return nil
+}
+
+// joinConfigPath joins config path prefix with key, key containing '.' is wrapped with '<>'
+func joinConfigPath(prefix, key string) string {
+	if strings.Contains(key, YamlConfigSeparator) {
+		key = "<" + key + ">"
+	}
+	if prefix == "" {
+		return key
+	}
+	return prefix + YamlConfigSeparator + key
+}
+
+func walkValue(v interface{}, path string, handler func(path, val string) (interface{}, error)) (interface{}, error) {
+	switch val := v.(type) {
+	case string:
+		return handler(path, val)
+	case Config:
+		return val, walkConfigStringValues(val, path, handler)
+	case AnyMap:
+		return val, walkConfigStringValues(val, path, handler)
+	case []interface{}:
+		for i, item := range val {
+			newItem, err := walkValue(item, path+"["+strconv.Itoa(i)+"]", handler)
+			if err != nil {
+				return val, err
+			}
+			val[i] = newItem
+		}
+		return val, nil
+	}
+	return v, nil
+}
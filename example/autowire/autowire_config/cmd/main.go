@@ -17,6 +17,7 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/alibaba/ioc-golang"
 	iocConfig "github.com/alibaba/ioc-golang/config"
@@ -35,6 +36,20 @@ type App struct {
 	DemoConfigSlice   *config.ConfigSlice   `config:",autowire.config.demo-config.slice-value"`
 	DemoConfigInt64   *config.ConfigInt64   `config:",autowire.config.demo-config.int64-value"`
 	DemoConfigFloat64 *config.ConfigFloat64 `config:",autowire.config.demo-config.float64-value"`
+
+	DemoConfigBool     *config.ConfigBool     `config:",autowire.config.demo-config.bool-value"`
+	DemoConfigDuration *config.ConfigDuration `config:",autowire.config.demo-config.duration-value"`
+	DemoConfigByteSize *config.ConfigByteSize `config:",autowire.config.demo-config.byte-size-value"`
+	DemoConfigURL      *config.ConfigURL      `config:",autowire.config.demo-config.url-value"`
+
+	DemoConfigStruct        *config.ConfigValue[DemoConfig]    `config:",autowire.config.demo-config.struct-value"`
+	DemoConfigValueDuration *config.ConfigValue[time.Duration] `config:",autowire.config.demo-config.duration-value"`
+}
+
+type DemoConfig struct {
+	Address string        `yaml:"address"`
+	Timeout time.Duration `yaml:"timeout"`
+	Enable  bool          `yaml:"enable"`
 }
 
 func (a *App) Run() {
@@ -44,6 +59,12 @@ func (a *App) Run() {
 	fmt.Println(a.DemoConfigSlice.Value())
 	fmt.Println(a.DemoConfigInt64.Value())
 	fmt.Println(a.DemoConfigFloat64.Value())
+	fmt.Println(a.DemoConfigBool.Value())
+	fmt.Println(a.DemoConfigDuration.Value())
+	fmt.Println(a.DemoConfigByteSize.Value())
+	fmt.Println(a.DemoConfigURL.Value())
+	fmt.Println(a.DemoConfigStruct.Value())
+	fmt.Println(a.DemoConfigValueDuration.Value())
 }
 
 func main() {
@@ -18,6 +18,7 @@ package main
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/alibaba/ioc-golang/config"
 
@@ -39,4 +40,10 @@ func TestConfig(t *testing.T) {
 	assert.Equal(t, 123, app.DemoConfigInt.Value())
 	assert.Equal(t, "map[key1:value1 key2:value2 key3:value3 obj:map[objkey1:objvalue1 objkey2:objvalue2 objkeyslice:objslicevalue]]", fmt.Sprint(app.DemoConfigMap.Value()))
 	assert.Equal(t, "[sliceValue1 sliceValue2 sliceValue3 sliceValue4]", fmt.Sprint(app.DemoConfigSlice.Value()))
+	assert.True(t, app.DemoConfigBool.Value())
+	assert.Equal(t, 3*time.Second, app.DemoConfigDuration.Value())
+	assert.Equal(t, int64(64<<20), app.DemoConfigByteSize.Value())
+	assert.Equal(t, "example.com:8080", app.DemoConfigURL.Value().Host)
+	assert.Equal(t, DemoConfig{Address: "localhost:6379", Timeout: 500 * time.Millisecond, Enable: true}, app.DemoConfigStruct.Value())
+	assert.Equal(t, 3*time.Second, app.DemoConfigValueDuration.Value())
 }
@@ -0,0 +1,418 @@
+// EDIT IT, change to your package, service and message
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.14.0
+// source: extension/aop/breaker/api/ioc_golang/aop/breaker/breaker.proto
+
+package breaker
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListBreakersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Breakers []*BreakerStatus `protobuf:"bytes,1,rep,name=breakers,proto3" json:"breakers,omitempty"`
+	AppName  string           `protobuf:"bytes,2,opt,name=appName,proto3" json:"appName,omitempty"`
+}
+
+func (x *ListBreakersResponse) Reset() {
+	*x = ListBreakersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListBreakersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBreakersResponse) ProtoMessage() {}
+
+func (x *ListBreakersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBreakersResponse.ProtoReflect.Descriptor instead.
+func (*ListBreakersResponse) Descriptor() ([]byte, []int) {
+	return file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListBreakersResponse) GetBreakers() []*BreakerStatus {
+	if x != nil {
+		return x.Breakers
+	}
+	return nil
+}
+
+func (x *ListBreakersResponse) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+type BreakerStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sdid   string `protobuf:"bytes,1,opt,name=sdid,proto3" json:"sdid,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// state is one of closed, open and half-open
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// forced is true if state is forced by iocli, which doesn't change until reset
+	Forced bool `protobuf:"varint,4,opt,name=forced,proto3" json:"forced,omitempty"`
+	// total, fail and slow are counts of calls in current statistic window
+	Total        int64   `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Fail         int64   `protobuf:"varint,6,opt,name=fail,proto3" json:"fail,omitempty"`
+	Slow         int64   `protobuf:"varint,7,opt,name=slow,proto3" json:"slow,omitempty"`
+	FailRate     float32 `protobuf:"fixed32,8,opt,name=failRate,proto3" json:"failRate,omitempty"`
+	SlowCallRate float32 `protobuf:"fixed32,9,opt,name=slowCallRate,proto3" json:"slowCallRate,omitempty"`
+	// rejected is count of calls rejected or sent to fallback since the breaker is created
+	Rejected int64 `protobuf:"varint,10,opt,name=rejected,proto3" json:"rejected,omitempty"`
+}
+
+func (x *BreakerStatus) Reset() {
+	*x = BreakerStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BreakerStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BreakerStatus) ProtoMessage() {}
+
+func (x *BreakerStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BreakerStatus.ProtoReflect.Descriptor instead.
+func (*BreakerStatus) Descriptor() ([]byte, []int) {
+	return file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BreakerStatus) GetSdid() string {
+	if x != nil {
+		return x.Sdid
+	}
+	return ""
+}
+
+func (x *BreakerStatus) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *BreakerStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *BreakerStatus) GetForced() bool {
+	if x != nil {
+		return x.Forced
+	}
+	return false
+}
+
+func (x *BreakerStatus) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BreakerStatus) GetFail() int64 {
+	if x != nil {
+		return x.Fail
+	}
+	return 0
+}
+
+func (x *BreakerStatus) GetSlow() int64 {
+	if x != nil {
+		return x.Slow
+	}
+	return 0
+}
+
+func (x *BreakerStatus) GetFailRate() float32 {
+	if x != nil {
+		return x.FailRate
+	}
+	return 0
+}
+
+func (x *BreakerStatus) GetSlowCallRate() float32 {
+	if x != nil {
+		return x.SlowCallRate
+	}
+	return 0
+}
+
+func (x *BreakerStatus) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+type ForceBreakerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sdid   string `protobuf:"bytes,1,opt,name=sdid,proto3" json:"sdid,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// state is open or closed to force the breaker, or empty to reset it to closed and let it switch automatically
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+}
+
+func (x *ForceBreakerRequest) Reset() {
+	*x = ForceBreakerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForceBreakerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForceBreakerRequest) ProtoMessage() {}
+
+func (x *ForceBreakerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForceBreakerRequest.ProtoReflect.Descriptor instead.
+func (*ForceBreakerRequest) Descriptor() ([]byte, []int) {
+	return file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ForceBreakerRequest) GetSdid() string {
+	if x != nil {
+		return x.Sdid
+	}
+	return ""
+}
+
+func (x *ForceBreakerRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ForceBreakerRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+var File_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto protoreflect.FileDescriptor
+
+var file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDesc = []byte{
+	0x0a, 0x3e, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x6f, 0x70, 0x2f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6f, 0x63, 0x5f,
+	0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61, 0x6f, 0x70, 0x2f, 0x62, 0x72, 0x65, 0x61, 0x6b,
+	0x65, 0x72, 0x2f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x16, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70,
+	0x2e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x73, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x65,
+	0x61, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x41, 0x0a,
+	0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70,
+	0x2e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x83, 0x02, 0x0a, 0x0d, 0x42,
+	0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x64, 0x69, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
+	0x66, 0x6f, 0x72, 0x63, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x66, 0x61, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x61, 0x69, 0x6c,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x77, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x73, 0x6c, 0x6f, 0x77, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6c, 0x6f, 0x77, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x61, 0x74, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x73, 0x6c, 0x6f, 0x77, 0x43, 0x61, 0x6c, 0x6c,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x22, 0x57, 0x0a, 0x13, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x64, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x64, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x32, 0xbf, 0x01, 0x0a, 0x0e, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4e, 0x0a, 0x04,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2c, 0x2e, 0x69,
+	0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x05,
+	0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x2b, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61,
+	0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x46,
+	0x6f, 0x72, 0x63, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e,
+	0x61, 0x6f, 0x70, 0x2e, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x65, 0x72, 0x2e, 0x42, 0x72, 0x65, 0x61,
+	0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x42, 0x18, 0x5a, 0x16, 0x69,
+	0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61, 0x6f, 0x70, 0x2f, 0x62, 0x72,
+	0x65, 0x61, 0x6b, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescOnce sync.Once
+	file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescData = file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDesc
+)
+
+func file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescGZIP() []byte {
+	file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescOnce.Do(func() {
+		file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescData = protoimpl.X.CompressGZIP(file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescData)
+	})
+	return file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDescData
+}
+
+var file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_goTypes = []interface{}{
+	(*ListBreakersResponse)(nil), // 0: ioc_golang.aop.breaker.ListBreakersResponse
+	(*BreakerStatus)(nil),        // 1: ioc_golang.aop.breaker.BreakerStatus
+	(*ForceBreakerRequest)(nil),  // 2: ioc_golang.aop.breaker.ForceBreakerRequest
+	(*emptypb.Empty)(nil),        // 3: google.protobuf.Empty
+}
+var file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_depIdxs = []int32{
+	1, // 0: ioc_golang.aop.breaker.ListBreakersResponse.breakers:type_name -> ioc_golang.aop.breaker.BreakerStatus
+	3, // 1: ioc_golang.aop.breaker.BreakerService.List:input_type -> google.protobuf.Empty
+	2, // 2: ioc_golang.aop.breaker.BreakerService.Force:input_type -> ioc_golang.aop.breaker.ForceBreakerRequest
+	0, // 3: ioc_golang.aop.breaker.BreakerService.List:output_type -> ioc_golang.aop.breaker.ListBreakersResponse
+	1, // 4: ioc_golang.aop.breaker.BreakerService.Force:output_type -> ioc_golang.aop.breaker.BreakerStatus
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_init() }
+func file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_init() {
+	if File_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBreakersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BreakerStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForceBreakerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_goTypes,
+		DependencyIndexes: file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_depIdxs,
+		MessageInfos:      file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_msgTypes,
+	}.Build()
+	File_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto = out.File
+	file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_rawDesc = nil
+	file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_goTypes = nil
+	file_extension_aop_breaker_api_ioc_golang_aop_breaker_breaker_proto_depIdxs = nil
+}
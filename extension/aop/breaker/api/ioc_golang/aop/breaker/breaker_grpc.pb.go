@@ -0,0 +1,139 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package breaker
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// BreakerServiceClient is the client API for BreakerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BreakerServiceClient interface {
+	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBreakersResponse, error)
+	Force(ctx context.Context, in *ForceBreakerRequest, opts ...grpc.CallOption) (*BreakerStatus, error)
+}
+
+type breakerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBreakerServiceClient(cc grpc.ClientConnInterface) BreakerServiceClient {
+	return &breakerServiceClient{cc}
+}
+
+func (c *breakerServiceClient) List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListBreakersResponse, error) {
+	out := new(ListBreakersResponse)
+	err := c.cc.Invoke(ctx, "/ioc_golang.aop.breaker.BreakerService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *breakerServiceClient) Force(ctx context.Context, in *ForceBreakerRequest, opts ...grpc.CallOption) (*BreakerStatus, error) {
+	out := new(BreakerStatus)
+	err := c.cc.Invoke(ctx, "/ioc_golang.aop.breaker.BreakerService/Force", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BreakerServiceServer is the server API for BreakerService service.
+// All implementations must embed UnimplementedBreakerServiceServer
+// for forward compatibility
+type BreakerServiceServer interface {
+	List(context.Context, *emptypb.Empty) (*ListBreakersResponse, error)
+	Force(context.Context, *ForceBreakerRequest) (*BreakerStatus, error)
+	mustEmbedUnimplementedBreakerServiceServer()
+}
+
+// UnimplementedBreakerServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedBreakerServiceServer struct {
+}
+
+func (UnimplementedBreakerServiceServer) List(context.Context, *emptypb.Empty) (*ListBreakersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedBreakerServiceServer) Force(context.Context, *ForceBreakerRequest) (*BreakerStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Force not implemented")
+}
+func (UnimplementedBreakerServiceServer) mustEmbedUnimplementedBreakerServiceServer() {}
+
+// UnsafeBreakerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BreakerServiceServer will
+// result in compilation errors.
+type UnsafeBreakerServiceServer interface {
+	mustEmbedUnimplementedBreakerServiceServer()
+}
+
+func RegisterBreakerServiceServer(s grpc.ServiceRegistrar, srv BreakerServiceServer) {
+	s.RegisterService(&BreakerService_ServiceDesc, srv)
+}
+
+func _BreakerService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreakerServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ioc_golang.aop.breaker.BreakerService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreakerServiceServer).List(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BreakerService_Force_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForceBreakerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BreakerServiceServer).Force(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ioc_golang.aop.breaker.BreakerService/Force",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BreakerServiceServer).Force(ctx, req.(*ForceBreakerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// BreakerService_ServiceDesc is the grpc.ServiceDesc for BreakerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var BreakerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ioc_golang.aop.breaker.BreakerService",
+	HandlerType: (*BreakerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _BreakerService_List_Handler,
+		},
+		{
+			MethodName: "Force",
+			Handler:    _BreakerService_Force_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "extension/aop/breaker/api/ioc_golang/aop/breaker/breaker.proto",
+}
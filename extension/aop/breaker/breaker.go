@@ -0,0 +1,212 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package breaker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	breakerPB "github.com/alibaba/ioc-golang/extension/aop/breaker/api/ioc_golang/aop/breaker"
+)
+
+type State string
+
+const (
+	StateClosed   State = "closed"
+	StateOpen     State = "open"
+	StateHalfOpen State = "half-open"
+)
+
+// OpenError is returned by calls rejected by open breaker, if fallback method is not set
+type OpenError struct {
+	SDID   string
+	Method string
+}
+
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("circuit breaker of %s.%s() is open", e.SDID, e.Method)
+}
+
+/*
+circuitBreaker of one method switches between states:
+
+- closed: all calls are permitted, and it switches to open if fail rate or slow call rate of calls in window reaches
+threshold, with at least MinRequests calls.
+- open: all calls are rejected, and it switches to half-open after OpenDuration.
+- half-open: HalfOpenRequests calls are permitted, and it switches to closed if all of them succeed, or to open if
+any of them fails or is slow.
+
+The state can be forced to open or closed by iocli, which doesn't change until reset.
+*/
+type circuitBreaker struct {
+	sdid   string
+	method string
+	rule   *Rule
+
+	lock        sync.Mutex
+	state       State
+	forced      bool
+	windowStart time.Time
+	openUntil   time.Time
+	total       int
+	fail        int
+	slow        int
+	// halfOpenPermitted and halfOpenSucceeded are counts of calls in half-open state
+	halfOpenPermitted int
+	halfOpenSucceeded int
+	rejected          int64
+}
+
+func newCircuitBreaker(sdid, method string, rule *Rule) *circuitBreaker {
+	return &circuitBreaker{
+		sdid:        sdid,
+		method:      method,
+		rule:        rule,
+		state:       StateClosed,
+		windowStart: time.Now(),
+	}
+}
+
+// allow returns if the call is permitted, and counts it as rejected if not
+func (b *circuitBreaker) allow() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	now := time.Now()
+	if !b.forced && b.state == StateOpen && !now.Before(b.openUntil) {
+		b.switchTo(StateHalfOpen, now)
+	}
+	switch b.state {
+	case StateClosed:
+		return true
+	case StateHalfOpen:
+		if b.halfOpenPermitted < b.rule.HalfOpenRequests {
+			b.halfOpenPermitted++
+			return true
+		}
+	}
+	b.rejected++
+	return false
+}
+
+// onResult records result of permitted call
+func (b *circuitBreaker) onResult(failed bool, duration time.Duration) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.forced {
+		return
+	}
+	now := time.Now()
+	slow := duration >= b.rule.SlowCallDuration
+	switch b.state {
+	case StateClosed:
+		if now.Sub(b.windowStart) >= b.rule.Window {
+			b.resetWindow(now)
+		}
+		b.total++
+		if failed {
+			b.fail++
+		}
+		if slow {
+			b.slow++
+		}
+		if b.total < b.rule.MinRequests {
+			return
+		}
+		if b.failRate() >= b.rule.FailRateThreshold || b.slowCallRate() >= b.rule.SlowCallRateThreshold {
+			b.switchTo(StateOpen, now)
+		}
+	case StateHalfOpen:
+		if failed || slow {
+			b.switchTo(StateOpen, now)
+			return
+		}
+		b.halfOpenSucceeded++
+		if b.halfOpenSucceeded >= b.rule.HalfOpenRequests {
+			b.switchTo(StateClosed, now)
+		}
+	}
+}
+
+// force sets state to open or closed and keeps it until reset, empty state resets the breaker to closed
+func (b *circuitBreaker) force(state State) error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	switch state {
+	case StateOpen, StateClosed:
+		b.forced = true
+	case "":
+		b.forced = false
+		state = StateClosed
+	default:
+		return fmt.Errorf("invalid state %s to force, should be %s or %s", state, StateOpen, StateClosed)
+	}
+	b.switchTo(state, time.Now())
+	return nil
+}
+
+func (b *circuitBreaker) switchTo(state State, now time.Time) {
+	b.state = state
+	b.resetWindow(now)
+	b.halfOpenPermitted = 0
+	b.halfOpenSucceeded = 0
+	if state == StateOpen {
+		b.openUntil = now.Add(b.rule.OpenDuration)
+	}
+}
+
+func (b *circuitBreaker) resetWindow(now time.Time) {
+	b.windowStart = now
+	b.total = 0
+	b.fail = 0
+	b.slow = 0
+}
+
+func (b *circuitBreaker) failRate() float64 {
+	if b.total == 0 {
+		return 0
+	}
+	return float64(b.fail) / float64(b.total)
+}
+
+func (b *circuitBreaker) slowCallRate() float64 {
+	if b.total == 0 {
+		return 0
+	}
+	return float64(b.slow) / float64(b.total)
+}
+
+func (b *circuitBreaker) describe() *breakerPB.BreakerStatus {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	state := b.state
+	if !b.forced && state == StateOpen && !time.Now().Before(b.openUntil) {
+		// it switches to half-open when the next call comes
+		state = StateHalfOpen
+	}
+	return &breakerPB.BreakerStatus{
+		Sdid:         b.sdid,
+		Method:       b.method,
+		State:        string(state),
+		Forced:       b.forced,
+		Total:        int64(b.total),
+		Fail:         int64(b.fail),
+		Slow:         int64(b.slow),
+		FailRate:     float32(b.failRate()),
+		SlowCallRate: float32(b.slowCallRate()),
+		Rejected:     b.rejected,
+	}
+}
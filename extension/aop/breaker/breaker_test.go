@@ -0,0 +1,132 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package breaker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/aop"
+)
+
+// newTestRule returns default rule overwritten by rawRule in config format
+func newTestRule(t *testing.T, rawRule map[string]interface{}) *Rule {
+	rule := newDefaultRule()
+	assert.Nil(t, aop.DecodeMethodRule(rawRule, rule))
+	return rule
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Run("open by fail rate and close after half-open calls succeed", func(t *testing.T) {
+		b := newCircuitBreaker("sdid", "Method", newTestRule(t, map[string]interface{}{
+			"fail-rate-threshold": 0.5,
+			"min-requests":        4,
+			"open-duration":       "50ms",
+			"half-open-requests":  2,
+		}))
+		for _, failed := range []bool{false, true, false} {
+			assert.True(t, b.allow())
+			b.onResult(failed, 0)
+		}
+		assert.Equal(t, StateClosed, b.state)
+
+		assert.True(t, b.allow())
+		b.onResult(true, 0)
+		assert.Equal(t, StateOpen, b.state)
+		assert.False(t, b.allow())
+		assert.Equal(t, int64(1), b.describe().Rejected)
+
+		time.Sleep(time.Millisecond * 60)
+		assert.Equal(t, string(StateHalfOpen), b.describe().State)
+		assert.True(t, b.allow())
+		assert.True(t, b.allow())
+		assert.False(t, b.allow())
+		assert.Equal(t, StateHalfOpen, b.state)
+		b.onResult(false, 0)
+		b.onResult(false, 0)
+		assert.Equal(t, StateClosed, b.state)
+	})
+
+	t.Run("open by slow call rate and reopen if half-open call fails", func(t *testing.T) {
+		b := newCircuitBreaker("sdid", "Method", newTestRule(t, map[string]interface{}{
+			"min-requests":             2,
+			"slow-call-duration":       "10ms",
+			"slow-call-rate-threshold": 0.5,
+			"open-duration":            "50ms",
+		}))
+		assert.True(t, b.allow())
+		b.onResult(false, 0)
+		assert.True(t, b.allow())
+		b.onResult(false, time.Millisecond*20)
+		assert.Equal(t, StateOpen, b.state)
+
+		time.Sleep(time.Millisecond * 60)
+		assert.True(t, b.allow())
+		b.onResult(true, 0)
+		assert.Equal(t, StateOpen, b.state)
+		assert.False(t, b.allow())
+	})
+
+	t.Run("reset counts after window passes", func(t *testing.T) {
+		b := newCircuitBreaker("sdid", "Method", newTestRule(t, map[string]interface{}{
+			"min-requests": 2,
+			"window":       "50ms",
+		}))
+		assert.True(t, b.allow())
+		b.onResult(true, 0)
+		time.Sleep(time.Millisecond * 60)
+		assert.True(t, b.allow())
+		b.onResult(true, 0)
+		assert.Equal(t, StateClosed, b.state)
+		assert.Equal(t, int64(1), b.describe().Total)
+	})
+
+	t.Run("force state", func(t *testing.T) {
+		b := newCircuitBreaker("sdid", "Method", newTestRule(t, map[string]interface{}{
+			"min-requests": 1,
+		}))
+		assert.Nil(t, b.force(StateOpen))
+		assert.False(t, b.allow())
+		assert.True(t, b.describe().Forced)
+
+		assert.Nil(t, b.force(StateClosed))
+		assert.True(t, b.allow())
+		b.onResult(true, 0)
+		assert.Equal(t, StateClosed, b.state)
+
+		assert.Nil(t, b.force(""))
+		assert.False(t, b.describe().Forced)
+		assert.True(t, b.allow())
+		b.onResult(true, 0)
+		assert.Equal(t, StateOpen, b.state)
+
+		assert.NotNil(t, b.force(StateHalfOpen))
+	})
+}
+
+func TestNewDefaultRule(t *testing.T) {
+	// explicit zero values are kept
+	rule := newTestRule(t, map[string]interface{}{
+		"min-requests":        0,
+		"fail-rate-threshold": 0,
+	})
+	assert.Equal(t, 0, rule.MinRequests)
+	assert.Equal(t, 0.0, rule.FailRateThreshold)
+	assert.Equal(t, defaultWindow, rule.Window)
+	assert.Equal(t, newDefaultRule(), newTestRule(t, nil))
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/alibaba/ioc-golang/extension/aop/breaker"
+	breakerPB "github.com/alibaba/ioc-golang/extension/aop/breaker/api/ioc_golang/aop/breaker"
+	"github.com/alibaba/ioc-golang/iocli/root"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+func getBreakerServiceClient(addr string) breakerPB.BreakerServiceClient {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	return breakerPB.NewBreakerServiceClient(conn)
+}
+
+var breakerCommand = &cobra.Command{
+	Use:   "breaker",
+	Short: "List circuit breakers of application, and force their states",
+	Long:  "List circuit breakers of application, and force their states",
+	Run: func(cmd *cobra.Command, args []string) {
+		breakerServiceClient := getBreakerServiceClient(fmt.Sprintf("%s:%d", debugHost, debugPort))
+		rsp, err := breakerServiceClient.List(context.Background(), &emptypb.Empty{})
+		if err != nil {
+			logger.Red(err.Error())
+			return
+		}
+		if rsp.AppName != "" {
+			logger.Blue("appName: %s", rsp.GetAppName())
+		}
+		for _, status := range rsp.Breakers {
+			printBreakerStatus(status)
+		}
+	},
+}
+
+func newForceCommand(use, state, short string) *cobra.Command {
+	return &cobra.Command{
+		Use:     use + " [sdid] [method]",
+		Short:   short,
+		Long:    short,
+		Example: fmt.Sprintf("  iocli breaker %s github.com/my/app/service.UserService GetUser", use),
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			breakerServiceClient := getBreakerServiceClient(fmt.Sprintf("%s:%d", debugHost, debugPort))
+			status, err := breakerServiceClient.Force(context.Background(), &breakerPB.ForceBreakerRequest{
+				Sdid:   args[0],
+				Method: args[1],
+				State:  state,
+			})
+			if err != nil {
+				logger.Red(err.Error())
+				return
+			}
+			printBreakerStatus(status)
+		},
+	}
+}
+
+func printBreakerStatus(status *breakerPB.BreakerStatus) {
+	state := status.GetState()
+	if status.GetForced() {
+		state += " (forced)"
+	}
+	logger.Blue("%s.%s()", status.GetSdid(), status.GetMethod())
+	if status.GetState() == string(breaker.StateClosed) {
+		logger.Cyan("  State: %s", state)
+	} else {
+		logger.Red("  State: %s", state)
+	}
+	logger.Blue("  Total: %d, Fail: %d, Slow: %d, FailRate: %.2f%%, SlowCallRate: %.2f%%, Rejected: %d",
+		status.GetTotal(), status.GetFail(), status.GetSlow(), status.GetFailRate()*100, status.GetSlowCallRate()*100,
+		status.GetRejected())
+}
+
+var (
+	debugHost string
+	debugPort int
+)
+
+func init() {
+	root.Cmd.AddCommand(breakerCommand)
+	breakerCommand.AddCommand(newForceCommand("open", string(breaker.StateOpen),
+		"Force circuit breaker of method to open, which rejects all calls until reset"))
+	breakerCommand.AddCommand(newForceCommand("close", string(breaker.StateClosed),
+		"Force circuit breaker of method to closed, which permits all calls until reset"))
+	breakerCommand.AddCommand(newForceCommand("reset", "",
+		"Reset circuit breaker of method to closed, and let it switch state automatically"))
+	breakerCommand.PersistentFlags().IntVarP(&debugPort, "port", "p", 1999, "debug port")
+	breakerCommand.PersistentFlags().StringVar(&debugHost, "host", "127.0.0.1", "debug host")
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package breaker
+
+import (
+	"time"
+)
+
+const (
+	defaultFailRateThreshold     = 0.5
+	defaultMinRequests           = 10
+	defaultSlowCallDuration      = time.Second
+	defaultSlowCallRateThreshold = 1
+	defaultWindow                = 10 * time.Second
+	defaultOpenDuration          = 30 * time.Second
+	defaultHalfOpenRequests      = 1
+)
+
+/*
+Rule is circuit breaker rule of one method, set by config 'ioc-golang.aop.breaker.<sdid>.<method>', like:
+
+	ioc-golang:
+	  aop:
+	    breaker:
+	      github.com/my/app/service.UserService:
+	        GetUser:
+	          fail-rate-threshold: 0.5
+	          min-requests: 20
+	          slow-call-duration: 500ms
+	          slow-call-rate-threshold: 0.8
+	          open-duration: 10s
+	          fallback: GetUserFallback
+*/
+type Rule struct {
+	// FailRateThreshold opens the breaker if rate of failed calls in window reaches it, default 0.5
+	FailRateThreshold float64 `yaml:"fail-rate-threshold"`
+	// MinRequests is min count of calls in window before the breaker can be opened, default 10
+	MinRequests int `yaml:"min-requests"`
+	// SlowCallDuration is duration that call taking longer than is slow, default 1s
+	SlowCallDuration time.Duration `yaml:"slow-call-duration"`
+	// SlowCallRateThreshold opens the breaker if rate of slow calls in window reaches it, default 1, which means
+	// slow calls only open the breaker if all calls are slow
+	SlowCallRateThreshold float64 `yaml:"slow-call-rate-threshold"`
+	// Window is duration of statistic window in closed state, counts are reset when window passes, default 10s
+	Window time.Duration `yaml:"window"`
+	// OpenDuration is duration of open state before switching to half-open, default 30s
+	OpenDuration time.Duration `yaml:"open-duration"`
+	// HalfOpenRequests is count of calls permitted in half-open state, which close the breaker if all succeed,
+	// default 1
+	HalfOpenRequests int `yaml:"half-open-requests"`
+	// Fallback is name of another method on the same struct with the same signature, which is called on the raw
+	// instance without interceptors when the breaker is open, calls fail fast with *OpenError if it's empty
+	Fallback string `yaml:"fallback"`
+}
+
+// BreakerConfig is config under 'ioc-golang.aop.breaker', sdid -> method name -> configured fields of rule, which
+// are kept as raw values and decoded into default rule, so that explicit zero values like 'min-requests: 0' are kept
+type BreakerConfig map[string]map[string]map[string]interface{}
+
+func newDefaultRule() *Rule {
+	return &Rule{
+		FailRateThreshold:     defaultFailRateThreshold,
+		MinRequests:           defaultMinRequests,
+		SlowCallDuration:      defaultSlowCallDuration,
+		SlowCallRateThreshold: defaultSlowCallRateThreshold,
+		Window:                defaultWindow,
+		OpenDuration:          defaultOpenDuration,
+		HalfOpenRequests:      defaultHalfOpenRequests,
+	}
+}
@@ -0,0 +1,134 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package breaker
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+var (
+	breakers     = make(map[string]*circuitBreaker)
+	breakersLock sync.RWMutex
+)
+
+// loadBreakers creates breakers of methods in config, which replaces all existing ones
+func loadBreakers(breakerConfig BreakerConfig) {
+	newBreakers := make(map[string]*circuitBreaker)
+	for sdid, methodRules := range breakerConfig {
+		for method, rawRule := range methodRules {
+			rule := newDefaultRule()
+			if err := aop.DecodeMethodRule(rawRule, rule); err != nil {
+				logger.Red("[AOP breaker] Invalid breaker config of %s.%s(), error = %s", sdid, method, err)
+			}
+			if rule.Fallback == method {
+				logger.Red("[AOP breaker] Fallback of %s.%s() can't be the method itself, calls fail fast instead",
+					sdid, method)
+				rule.Fallback = ""
+			}
+			newBreakers[common.GetMethodUniqueKey(sdid, method)] = newCircuitBreaker(sdid, method, rule)
+		}
+	}
+	breakersLock.Lock()
+	breakers = newBreakers
+	breakersLock.Unlock()
+}
+
+func getBreaker(sdid, method string) *circuitBreaker {
+	breakersLock.RLock()
+	defer breakersLock.RUnlock()
+	return breakers[common.GetMethodUniqueKey(sdid, method)]
+}
+
+// getSortedBreakers returns all breakers sorted by sdid and method
+func getSortedBreakers() []*circuitBreaker {
+	breakersLock.RLock()
+	sortedBreakers := make([]*circuitBreaker, 0, len(breakers))
+	for _, b := range breakers {
+		sortedBreakers = append(sortedBreakers, b)
+	}
+	breakersLock.RUnlock()
+	sort.Slice(sortedBreakers, func(i, j int) bool {
+		if sortedBreakers[i].sdid != sortedBreakers[j].sdid {
+			return sortedBreakers[i].sdid < sortedBreakers[j].sdid
+		}
+		return sortedBreakers[i].method < sortedBreakers[j].method
+	})
+	return sortedBreakers
+}
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:proxy=false
+
+/*
+interceptorImpl rejects calls of method whose circuit breaker is open, by calling the fallback method, or returning
+*OpenError as the last return value, or zero values with rejection logged if method has no error return. Permitted calls are recorded to the breaker, failed ones are decided by
+IsInvocationFailed of return values.
+*/
+type interceptorImpl struct {
+}
+
+func (i *interceptorImpl) Invoke(ctx *aop.InvocationContext, next func() []reflect.Value) []reflect.Value {
+	b := getBreaker(ctx.SDID, ctx.MethodName)
+	if b == nil {
+		return next()
+	}
+	if !b.allow() {
+		return reject(ctx, b)
+	}
+	start := time.Now()
+	// record result in defer, so that the permit of half-open breaker is settled as failure if next() panics
+	failed := true
+	defer func() {
+		b.onResult(failed || ctx.Panic != nil, time.Since(start))
+	}()
+	returnValues := next()
+	failed, _ = common.IsInvocationFailed(returnValues)
+	return returnValues
+}
+
+/*
+reject calls fallback method on the raw instance if it's set and valid, or returns *OpenError. Fallback is not called
+through the proxy, so that it doesn't run interceptors again as a nested invocation, or recurse if it's also broken.
+*/
+func reject(ctx *aop.InvocationContext, b *circuitBreaker) []reflect.Value {
+	if b.rule.Fallback != "" && ctx.ProxyServicePtr != nil && ctx.CallRawMethod != nil {
+		fallback := reflect.ValueOf(ctx.ProxyServicePtr).MethodByName(b.rule.Fallback)
+		if fallback.IsValid() && fallback.Type() == ctx.MethodType {
+			if returnValues, ok := ctx.CallRawMethod(b.rule.Fallback, ctx.Params); ok {
+				return returnValues
+			}
+		}
+		logger.Red("[AOP breaker] Fallback method %s of %s.%s() is not found or has different signature",
+			b.rule.Fallback, ctx.SDID, ctx.MethodName)
+	}
+	err := &OpenError{
+		SDID:   ctx.SDID,
+		Method: ctx.MethodName,
+	}
+	if !ctx.ReturnsError() {
+		// rejection is counted by breaker, and logged as caller gets zero values without error
+		logger.Red("[AOP breaker] %s, zero values are returned as the method has no error return", err)
+	}
+	return ctx.ReturnValuesWithError(err)
+}
@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package breaker
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/aop"
+)
+
+const testSDID = "github.com/alibaba/ioc-golang/extension/aop/breaker.testService"
+
+type testService struct {
+	Call_ func(name string) (string, error)
+}
+
+func (t *testService) Call(name string) (string, error) {
+	return t.Call_(name)
+}
+
+func (t *testService) CallFallback(name string) (string, error) {
+	return "fallback " + name, nil
+}
+
+func (t *testService) InvalidFallback() string {
+	return "invalid"
+}
+
+func TestInterceptorImpl_Invoke(t *testing.T) {
+	service := &testService{}
+	methodType := reflect.TypeOf(service.Call)
+	rawCalls := make([]string, 0)
+	newCtx := func() *aop.InvocationContext {
+		return &aop.InvocationContext{
+			ProxyServicePtr: service,
+			SDID:            testSDID,
+			MethodName:      "Call",
+			MethodType:      methodType,
+			Params:          []reflect.Value{reflect.ValueOf("ioc")},
+			CallRawMethod: func(methodName string, params []reflect.Value) ([]reflect.Value, bool) {
+				rawCalls = append(rawCalls, methodName)
+				return reflect.ValueOf(service).MethodByName(methodName).Call(params), true
+			},
+		}
+	}
+	failedNext := func() []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(""), reflect.ValueOf(errors.New("failed"))}
+	}
+	interceptor := &interceptorImpl{}
+
+	tests := []struct {
+		name         string
+		fallback     string
+		wantResult   string
+		wantErr      error
+		wantRawCalls []string
+	}{
+		{
+			name:    "fail fast with open error",
+			wantErr: &OpenError{SDID: testSDID, Method: "Call"},
+		},
+		{
+			name:         "call fallback on raw instance",
+			fallback:     "CallFallback",
+			wantResult:   "fallback ioc",
+			wantRawCalls: []string{"CallFallback"},
+		},
+		{
+			name:     "fail fast with invalid fallback",
+			fallback: "InvalidFallback",
+			wantErr:  &OpenError{SDID: testSDID, Method: "Call"},
+		},
+		{
+			name:     "fail fast with fallback of the method itself",
+			fallback: "Call",
+			wantErr:  &OpenError{SDID: testSDID, Method: "Call"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loadBreakers(BreakerConfig{
+				testSDID: {
+					"Call": {
+						"min-requests": 1,
+						"fallback":     tt.fallback,
+					},
+				},
+			})
+			defer loadBreakers(BreakerConfig{})
+			rawCalls = rawCalls[:0]
+
+			ctx := newCtx()
+			returnValues := interceptor.Invoke(ctx, failedNext)
+			assert.Equal(t, "failed", returnValues[1].Interface().(error).Error())
+			assert.Equal(t, StateOpen, getBreaker(testSDID, "Call").state)
+
+			ctx = newCtx()
+			returnValues = interceptor.Invoke(ctx, func() []reflect.Value {
+				assert.Fail(t, "call should be rejected")
+				return nil
+			})
+			assert.Equal(t, tt.wantResult, returnValues[0].Interface())
+			assert.Equal(t, len(tt.wantRawCalls), len(rawCalls))
+			if len(tt.wantRawCalls) > 0 {
+				assert.Equal(t, tt.wantRawCalls, rawCalls)
+			}
+			if tt.wantErr == nil {
+				assert.True(t, returnValues[1].IsNil())
+				return
+			}
+			var openErr *OpenError
+			assert.True(t, errors.As(returnValues[1].Interface().(error), &openErr))
+			assert.Equal(t, tt.wantErr, openErr)
+		})
+	}
+
+	t.Run("panic in half-open state", func(t *testing.T) {
+		loadBreakers(BreakerConfig{
+			testSDID: {
+				"Call": {
+					"min-requests":  1,
+					"open-duration": "1ms",
+				},
+			},
+		})
+		defer loadBreakers(BreakerConfig{})
+
+		interceptor.Invoke(newCtx(), failedNext)
+		assert.Equal(t, StateOpen, getBreaker(testSDID, "Call").state)
+		time.Sleep(time.Millisecond * 5)
+
+		assert.Panics(t, func() {
+			interceptor.Invoke(newCtx(), func() []reflect.Value {
+				panic("call panics")
+			})
+		})
+		assert.Equal(t, StateOpen, getBreaker(testSDID, "Call").state)
+
+		time.Sleep(time.Millisecond * 5)
+		returnValues := interceptor.Invoke(newCtx(), func() []reflect.Value {
+			return []reflect.Value{reflect.ValueOf("ok"), reflect.Zero(reflect.TypeOf((*error)(nil)).Elem())}
+		})
+		assert.Equal(t, "ok", returnValues[0].Interface())
+		assert.Equal(t, StateClosed, getBreaker(testSDID, "Call").state)
+	})
+
+	t.Run("method without breaker", func(t *testing.T) {
+		ctx := newCtx()
+		ctx.MethodName = "NotConfigured"
+		for i := 0; i < 20; i++ {
+			returnValues := interceptor.Invoke(ctx, failedNext)
+			assert.Equal(t, "failed", returnValues[1].Interface().(error).Error())
+		}
+	})
+}
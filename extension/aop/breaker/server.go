@@ -0,0 +1,70 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package breaker
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	breakerPB "github.com/alibaba/ioc-golang/extension/aop/breaker/api/ioc_golang/aop/breaker"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:paramType=breakerServiceParam
+// +ioc:autowire:constructFunc=Init
+// +ioc:autowire:proxy=false
+
+type breakerService struct {
+	breakerPB.UnimplementedBreakerServiceServer
+	appName string
+}
+
+type breakerServiceParam struct {
+	AppName string
+}
+
+func (p *breakerServiceParam) Init(s *breakerService) (*breakerService, error) {
+	s.appName = p.AppName
+	return s, nil
+}
+
+func (s *breakerService) List(_ context.Context, _ *emptypb.Empty) (*breakerPB.ListBreakersResponse, error) {
+	sortedBreakers := getSortedBreakers()
+	statuses := make([]*breakerPB.BreakerStatus, 0, len(sortedBreakers))
+	for _, b := range sortedBreakers {
+		statuses = append(statuses, b.describe())
+	}
+	return &breakerPB.ListBreakersResponse{
+		Breakers: statuses,
+		AppName:  s.appName,
+	}, nil
+}
+
+func (s *breakerService) Force(_ context.Context, req *breakerPB.ForceBreakerRequest) (*breakerPB.BreakerStatus, error) {
+	b := getBreaker(req.GetSdid(), req.GetMethod())
+	if b == nil {
+		return nil, fmt.Errorf("circuit breaker of %s.%s() is not found", req.GetSdid(), req.GetMethod())
+	}
+	if err := b.force(State(req.GetState())); err != nil {
+		return nil, err
+	}
+	logger.Red("[Debug Server] Circuit breaker of %s.%s() is forced to %s", req.GetSdid(), req.GetMethod(), req.GetState())
+	return b.describe(), nil
+}
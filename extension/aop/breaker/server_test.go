@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package breaker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	breakerPB "github.com/alibaba/ioc-golang/extension/aop/breaker/api/ioc_golang/aop/breaker"
+)
+
+func TestBreakerService(t *testing.T) {
+	loadBreakers(BreakerConfig{
+		testSDID: {
+			"Call":   nil,
+			"Call2":  {},
+			"Absent": {},
+		},
+	})
+	defer loadBreakers(BreakerConfig{})
+	service, err := GetbreakerServiceSingleton(&breakerServiceParam{
+		AppName: "test-app",
+	})
+	assert.Nil(t, err)
+
+	rsp, err := service.List(context.TODO(), &emptypb.Empty{})
+	assert.Nil(t, err)
+	assert.Equal(t, "test-app", rsp.GetAppName())
+	assert.Equal(t, 3, len(rsp.GetBreakers()))
+	assert.Equal(t, "Absent", rsp.GetBreakers()[0].GetMethod())
+	assert.Equal(t, "Call", rsp.GetBreakers()[1].GetMethod())
+	assert.Equal(t, string(StateClosed), rsp.GetBreakers()[1].GetState())
+
+	status, err := service.Force(context.TODO(), &breakerPB.ForceBreakerRequest{
+		Sdid:   testSDID,
+		Method: "Call",
+		State:  string(StateOpen),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, string(StateOpen), status.GetState())
+	assert.True(t, status.GetForced())
+
+	_, err = service.Force(context.TODO(), &breakerPB.ForceBreakerRequest{
+		Sdid:   testSDID,
+		Method: "NotFound",
+		State:  string(StateOpen),
+	})
+	assert.NotNil(t, err)
+
+	_, err = service.Force(context.TODO(), &breakerPB.ForceBreakerRequest{
+		Sdid:   testSDID,
+		Method: "Call",
+		State:  "invalid",
+	})
+	assert.NotNil(t, err)
+}
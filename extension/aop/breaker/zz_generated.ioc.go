@@ -0,0 +1,78 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by iocli, run 'iocli gen' to re-generate
+
+package breaker
+
+import (
+	autowire "github.com/alibaba/ioc-golang/autowire"
+	singleton "github.com/alibaba/ioc-golang/autowire/singleton"
+	util "github.com/alibaba/ioc-golang/autowire/util"
+)
+
+func init() {
+	interceptorImplStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &interceptorImpl{}
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	singleton.RegisterStructDescriptor(interceptorImplStructDescriptor)
+	breakerServiceStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &breakerService{}
+		},
+		ParamFactory: func() interface{} {
+			var _ breakerServiceParamInterface = &breakerServiceParam{}
+			return &breakerServiceParam{}
+		},
+		ConstructFunc: func(i interface{}, p interface{}) (interface{}, error) {
+			param := p.(breakerServiceParamInterface)
+			impl := i.(*breakerService)
+			return param.Init(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	singleton.RegisterStructDescriptor(breakerServiceStructDescriptor)
+}
+
+type breakerServiceParamInterface interface {
+	Init(impl *breakerService) (*breakerService, error)
+}
+
+var _interceptorImplSDID string
+
+func GetinterceptorImplSingleton() (*interceptorImpl, error) {
+	if _interceptorImplSDID == "" {
+		_interceptorImplSDID = util.GetSDIDByStructPtr(new(interceptorImpl))
+	}
+	i, err := singleton.GetImpl(_interceptorImplSDID, nil)
+	if err != nil {
+		return nil, err
+	}
+	impl := i.(*interceptorImpl)
+	return impl, nil
+}
+
+var _breakerServiceSDID string
+
+func GetbreakerServiceSingleton(p *breakerServiceParam) (*breakerService, error) {
+	if _breakerServiceSDID == "" {
+		_breakerServiceSDID = util.GetSDIDByStructPtr(new(breakerService))
+	}
+	i, err := singleton.GetImpl(_breakerServiceSDID, p)
+	if err != nil {
+		return nil, err
+	}
+	impl := i.(*breakerService)
+	return impl, nil
+}
@@ -0,0 +1,64 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"fmt"
+
+	"google.golang.org/grpc"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/config"
+	cachePB "github.com/alibaba/ioc-golang/extension/aop/cache/api/ioc_golang/aop/cache"
+)
+
+const Name = "cache"
+
+var cacheConfig = CacheConfig{}
+
+func init() {
+	aop.RegisterAOP(aop.AOP{
+		Name:  Name,
+		Order: aop.OrderCache,
+		Pointcut: &aop.Pointcut{
+			Matcher: func(sd *autowire.StructDescriptor, methodName string) bool {
+				return sd != nil && getMethodCache(sd.ID(), methodName) != nil
+			},
+		},
+		ConfigLoader: func(aopConfig *common.Config) {
+			loadedConfig := CacheConfig{}
+			_ = config.LoadConfigByPrefix(fmt.Sprintf("%s.%s", common.IOCGolangAOPConfigPrefix, Name), &loadedConfig)
+			cacheConfig = loadedConfig
+			resetMethodCaches()
+			_, _ = GetcacheServiceSingleton(&cacheServiceParam{
+				AppName: aopConfig.AppName,
+			})
+		},
+		AroundInterceptorFactory: func() aop.AroundInterceptor {
+			interceptor, err := GetinterceptorImplSingleton()
+			if err != nil {
+				return nil
+			}
+			return interceptor
+		},
+		GRPCServiceRegister: func(server *grpc.Server) {
+			cacheServiceSingleton, _ := GetcacheServiceSingleton(nil)
+			cachePB.RegisterCacheServiceServer(server, cacheServiceSingleton)
+		},
+	})
+}
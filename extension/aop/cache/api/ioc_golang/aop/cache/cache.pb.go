@@ -0,0 +1,449 @@
+// EDIT IT, change to your package, service and message
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.14.0
+// source: extension/aop/cache/api/ioc_golang/aop/cache/cache.proto
+
+package cache
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListCachesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Caches  []*CacheStatus `protobuf:"bytes,1,rep,name=caches,proto3" json:"caches,omitempty"`
+	AppName string         `protobuf:"bytes,2,opt,name=appName,proto3" json:"appName,omitempty"`
+}
+
+func (x *ListCachesResponse) Reset() {
+	*x = ListCachesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCachesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCachesResponse) ProtoMessage() {}
+
+func (x *ListCachesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCachesResponse.ProtoReflect.Descriptor instead.
+func (*ListCachesResponse) Descriptor() ([]byte, []int) {
+	return file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListCachesResponse) GetCaches() []*CacheStatus {
+	if x != nil {
+		return x.Caches
+	}
+	return nil
+}
+
+func (x *ListCachesResponse) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+type CacheStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sdid   string `protobuf:"bytes,1,opt,name=sdid,proto3" json:"sdid,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Store  string `protobuf:"bytes,3,opt,name=store,proto3" json:"store,omitempty"`
+	// ttl and negativeTTL are expirations of successful and failed results, like '1m0s'
+	Ttl         string `protobuf:"bytes,4,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	NegativeTTL string `protobuf:"bytes,5,opt,name=negativeTTL,proto3" json:"negativeTTL,omitempty"`
+	// hits and misses are counts of calls since the cache is created
+	Hits   int64 `protobuf:"varint,6,opt,name=hits,proto3" json:"hits,omitempty"`
+	Misses int64 `protobuf:"varint,7,opt,name=misses,proto3" json:"misses,omitempty"`
+	// entries is count of cached results, -1 means the store doesn't support counting
+	Entries int64 `protobuf:"varint,8,opt,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *CacheStatus) Reset() {
+	*x = CacheStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStatus) ProtoMessage() {}
+
+func (x *CacheStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStatus.ProtoReflect.Descriptor instead.
+func (*CacheStatus) Descriptor() ([]byte, []int) {
+	return file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CacheStatus) GetSdid() string {
+	if x != nil {
+		return x.Sdid
+	}
+	return ""
+}
+
+func (x *CacheStatus) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *CacheStatus) GetStore() string {
+	if x != nil {
+		return x.Store
+	}
+	return ""
+}
+
+func (x *CacheStatus) GetTtl() string {
+	if x != nil {
+		return x.Ttl
+	}
+	return ""
+}
+
+func (x *CacheStatus) GetNegativeTTL() string {
+	if x != nil {
+		return x.NegativeTTL
+	}
+	return ""
+}
+
+func (x *CacheStatus) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheStatus) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheStatus) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+// EvictCacheRequest evicts cached results of method, or all cached methods of sdid if method is empty
+type EvictCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sdid   string `protobuf:"bytes,1,opt,name=sdid,proto3" json:"sdid,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *EvictCacheRequest) Reset() {
+	*x = EvictCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvictCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictCacheRequest) ProtoMessage() {}
+
+func (x *EvictCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictCacheRequest.ProtoReflect.Descriptor instead.
+func (*EvictCacheRequest) Descriptor() ([]byte, []int) {
+	return file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *EvictCacheRequest) GetSdid() string {
+	if x != nil {
+		return x.Sdid
+	}
+	return ""
+}
+
+func (x *EvictCacheRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type EvictCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Methods []string `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
+}
+
+func (x *EvictCacheResponse) Reset() {
+	*x = EvictCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EvictCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EvictCacheResponse) ProtoMessage() {}
+
+func (x *EvictCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EvictCacheResponse.ProtoReflect.Descriptor instead.
+func (*EvictCacheResponse) Descriptor() ([]byte, []int) {
+	return file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EvictCacheResponse) GetMethods() []string {
+	if x != nil {
+		return x.Methods
+	}
+	return nil
+}
+
+var File_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto protoreflect.FileDescriptor
+
+var file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDesc = []byte{
+	0x0a, 0x38, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x6f, 0x70, 0x2f,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f,
+	0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61, 0x6f, 0x70, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2f, 0x63,
+	0x61, 0x63, 0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x69, 0x6f, 0x63, 0x5f,
+	0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67,
+	0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x0b, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x64, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x64, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x74,
+	0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x74, 0x6c, 0x12, 0x20, 0x0a, 0x0b,
+	0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x54, 0x4c, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x67, 0x61, 0x74, 0x69, 0x76, 0x65, 0x54, 0x54, 0x4c, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x68, 0x69,
+	0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x3f, 0x0a, 0x11, 0x45, 0x76, 0x69, 0x63, 0x74, 0x43, 0x61, 0x63,
+	0x68, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x64, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x64, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x45, 0x76, 0x69, 0x63, 0x74, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x73, 0x32, 0xb8, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c,
+	0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5c, 0x0a, 0x05, 0x45, 0x76, 0x69, 0x63, 0x74, 0x12, 0x27, 0x2e, 0x69, 0x6f,
+	0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x63, 0x61, 0x63,
+	0x68, 0x65, 0x2e, 0x45, 0x76, 0x69, 0x63, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
+	0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x45, 0x76, 0x69, 0x63,
+	0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x16, 0x5a, 0x14, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61,
+	0x6f, 0x70, 0x2f, 0x63, 0x61, 0x63, 0x68, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescOnce sync.Once
+	file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescData = file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDesc
+)
+
+func file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescGZIP() []byte {
+	file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescOnce.Do(func() {
+		file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescData = protoimpl.X.CompressGZIP(file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescData)
+	})
+	return file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDescData
+}
+
+var file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_goTypes = []interface{}{
+	(*ListCachesResponse)(nil), // 0: ioc_golang.aop.cache.ListCachesResponse
+	(*CacheStatus)(nil),        // 1: ioc_golang.aop.cache.CacheStatus
+	(*EvictCacheRequest)(nil),  // 2: ioc_golang.aop.cache.EvictCacheRequest
+	(*EvictCacheResponse)(nil), // 3: ioc_golang.aop.cache.EvictCacheResponse
+	(*emptypb.Empty)(nil),      // 4: google.protobuf.Empty
+}
+var file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_depIdxs = []int32{
+	1, // 0: ioc_golang.aop.cache.ListCachesResponse.caches:type_name -> ioc_golang.aop.cache.CacheStatus
+	4, // 1: ioc_golang.aop.cache.CacheService.List:input_type -> google.protobuf.Empty
+	2, // 2: ioc_golang.aop.cache.CacheService.Evict:input_type -> ioc_golang.aop.cache.EvictCacheRequest
+	0, // 3: ioc_golang.aop.cache.CacheService.List:output_type -> ioc_golang.aop.cache.ListCachesResponse
+	3, // 4: ioc_golang.aop.cache.CacheService.Evict:output_type -> ioc_golang.aop.cache.EvictCacheResponse
+	3, // [3:5] is the sub-list for method output_type
+	1, // [1:3] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_init() }
+func file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_init() {
+	if File_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCachesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvictCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EvictCacheResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_goTypes,
+		DependencyIndexes: file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_depIdxs,
+		MessageInfos:      file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_msgTypes,
+	}.Build()
+	File_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto = out.File
+	file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_rawDesc = nil
+	file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_goTypes = nil
+	file_extension_aop_cache_api_ioc_golang_aop_cache_cache_proto_depIdxs = nil
+}
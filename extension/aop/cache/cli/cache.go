@@ -0,0 +1,103 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	cachePB "github.com/alibaba/ioc-golang/extension/aop/cache/api/ioc_golang/aop/cache"
+	"github.com/alibaba/ioc-golang/iocli/root"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+func getCacheServiceClient(addr string) cachePB.CacheServiceClient {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	return cachePB.NewCacheServiceClient(conn)
+}
+
+var cacheCommand = &cobra.Command{
+	Use:   "cache",
+	Short: "List method result caches of application, and evict them at runtime",
+	Long:  "List method result caches of application, and evict them at runtime",
+	Run: func(cmd *cobra.Command, args []string) {
+		cacheServiceClient := getCacheServiceClient(fmt.Sprintf("%s:%d", debugHost, debugPort))
+		rsp, err := cacheServiceClient.List(context.Background(), &emptypb.Empty{})
+		if err != nil {
+			logger.Red(err.Error())
+			return
+		}
+		if rsp.AppName != "" {
+			logger.Blue("appName: %s", rsp.GetAppName())
+		}
+		for _, status := range rsp.Caches {
+			logger.Blue("%s.%s()", status.GetSdid(), status.GetMethod())
+			logger.Cyan("  Store: %s, TTL: %s, NegativeTTL: %s", status.GetStore(), status.GetTtl(),
+				status.GetNegativeTTL())
+			entries := "unknown"
+			if status.GetEntries() >= 0 {
+				entries = fmt.Sprintf("%d", status.GetEntries())
+			}
+			logger.Blue("  Hits: %d, Misses: %d, Entries: %s", status.GetHits(), status.GetMisses(), entries)
+		}
+	},
+}
+
+var evictCommand = &cobra.Command{
+	Use:   "evict [sdid] [method]",
+	Short: "Evict cached results of method, or all cached methods of struct if method is not given",
+	Long:  "Evict cached results of method, or all cached methods of struct if method is not given",
+	Example: `  iocli cache evict github.com/my/app/service.UserService GetUser
+  iocli cache evict github.com/my/app/service.UserService`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		req := &cachePB.EvictCacheRequest{
+			Sdid: args[0],
+		}
+		if len(args) > 1 {
+			req.Method = args[1]
+		}
+		cacheServiceClient := getCacheServiceClient(fmt.Sprintf("%s:%d", debugHost, debugPort))
+		rsp, err := cacheServiceClient.Evict(context.Background(), req)
+		if err != nil {
+			logger.Red(err.Error())
+			return
+		}
+		for _, method := range rsp.GetMethods() {
+			logger.Blue("Cache of %s.%s() is evicted", req.GetSdid(), method)
+		}
+	},
+}
+
+var (
+	debugHost string
+	debugPort int
+)
+
+func init() {
+	root.Cmd.AddCommand(cacheCommand)
+	cacheCommand.AddCommand(evictCommand)
+	cacheCommand.PersistentFlags().IntVarP(&debugPort, "port", "p", 1999, "debug port")
+	cacheCommand.PersistentFlags().StringVar(&debugHost, "host", "127.0.0.1", "debug host")
+}
@@ -0,0 +1,45 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"sigs.k8s.io/controller-tools/pkg/markers"
+
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin/common"
+)
+
+const cacheAnnotation = "ioc:aop:cache"
+
+// +ioc:autowire=true
+// +ioc:autowire:type=allimpls
+// +ioc:autowire:proxy=false
+// +ioc:autowire:implements=github.com/alibaba/ioc-golang/iocli/gen/marker.DefinitionGetter
+
+type cacheMarker struct {
+}
+
+func (m *cacheMarker) GetMarkerDefinition() *markers.Definition {
+	return common.NewMethodMarkerDefinition(cacheAnnotation, cacheMarkerArgs{})
+}
+
+// cacheMarkerArgs is args of marker '+ioc:aop:cache:method=GetUser,ttl=1m,maxEntries=100,negativeTTL=5s,store=redis'
+type cacheMarkerArgs struct {
+	Method      string `marker:"method"`
+	TTL         string `marker:"ttl,optional" rule:"ttl"`
+	MaxEntries  int    `marker:"maxEntries,optional" rule:"max-entries"`
+	NegativeTTL string `marker:"negativeTTL,optional" rule:"negative-ttl"`
+	Store       string `marker:"store,optional" rule:"store"`
+}
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"github.com/alibaba/ioc-golang/extension/aop/cache"
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin/common"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:type=allimpls
+// +ioc:autowire:proxy=false
+// +ioc:autowire:implements=github.com/alibaba/ioc-golang/iocli/gen/generator/plugin.CodeGeneratorPluginForOneStruct
+// +ioc:autowire:allimpls:autowireType=normal
+// +ioc:autowire:constructFunc=create
+
+// cacheCodeGenerationPlugin generates cache rules of methods with keys of config 'ioc-golang.aop.cache'
+type cacheCodeGenerationPlugin struct {
+	common.MethodMarkerPlugin
+}
+
+func create(c *cacheCodeGenerationPlugin) (*cacheCodeGenerationPlugin, error) {
+	c.MethodMarkerPlugin = common.NewMethodMarkerPlugin(cache.Name, cacheAnnotation)
+	return c, nil
+}
@@ -0,0 +1,65 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by iocli, run 'iocli gen' to re-generate
+
+package cli
+
+import (
+	autowire "github.com/alibaba/ioc-golang/autowire"
+	allimpls "github.com/alibaba/ioc-golang/extension/autowire/allimpls"
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin"
+	marker "github.com/alibaba/ioc-golang/iocli/gen/marker"
+)
+
+func init() {
+	cacheMarkerStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &cacheMarker{}
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(marker.DefinitionGetter),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(cacheMarkerStructDescriptor)
+	var _ marker.DefinitionGetter = &cacheMarker{}
+	cacheCodeGenerationPluginStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &cacheCodeGenerationPlugin{}
+		},
+		ConstructFunc: func(i interface{}, _ interface{}) (interface{}, error) {
+			impl := i.(*cacheCodeGenerationPlugin)
+			var constructFunc cacheCodeGenerationPluginConstructFunc = create
+			return constructFunc(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"allimpls": map[string]interface{}{
+					"autowireType": "normal",
+				},
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(plugin.CodeGeneratorPluginForOneStruct),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(cacheCodeGenerationPluginStructDescriptor)
+	var _ plugin.CodeGeneratorPluginForOneStruct = &cacheCodeGenerationPlugin{}
+}
+
+type cacheCodeGenerationPluginConstructFunc func(impl *cacheCodeGenerationPlugin) (*cacheCodeGenerationPlugin, error)
+
+var _cacheMarkerSDID string
+var _cacheCodeGenerationPluginSDID string
@@ -0,0 +1,117 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+var (
+	jsonMarshalerType   = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// hashParams returns hash of params serialized to json, context.Context param is skipped
+func hashParams(params []reflect.Value) (string, error) {
+	rawParams := make([]interface{}, 0, len(params))
+	for _, p := range params {
+		if p.Type() == contextType {
+			continue
+		}
+		rawParams = append(rawParams, p.Interface())
+	}
+	data, err := json.Marshal(rawParams)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// checkParamsSerializable returns error if any param except context.Context can't be serialized to cache key
+func checkParamsSerializable(methodType reflect.Type) error {
+	for i := 0; i < methodType.NumIn(); i++ {
+		if methodType.In(i) == contextType {
+			continue
+		}
+		if err := CheckSerializable(methodType.In(i)); err != nil {
+			return fmt.Errorf("param %d can't be serialized, %s", i, err)
+		}
+	}
+	return nil
+}
+
+/*
+CheckSerializable returns error if value of type t doesn't survive json round trip, like types with unexported or
+ignored fields, interfaces including error, functions and channels. Types implementing both json.Marshaler and
+json.Unmarshaler, like time.Time, are trusted.
+*/
+func CheckSerializable(t reflect.Type) error {
+	return checkSerializable(t, make(map[reflect.Type]bool))
+}
+
+func checkSerializable(t reflect.Type, checked map[reflect.Type]bool) error {
+	if checked[t] {
+		// recursive type
+		return nil
+	}
+	checked[t] = true
+	if t.Implements(jsonMarshalerType) && reflect.PtrTo(t).Implements(jsonUnmarshalerType) {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Bool, reflect.String, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return nil
+	case reflect.Ptr, reflect.Slice, reflect.Array:
+		return checkSerializable(t.Elem(), checked)
+	case reflect.Map:
+		switch t.Key().Kind() {
+		case reflect.String, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		default:
+			return fmt.Errorf("key type of %s is not string or integer", t)
+		}
+		return checkSerializable(t.Elem(), checked)
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			if field.Tag.Get("json") == "-" {
+				return fmt.Errorf("field %s of %s is ignored by json", field.Name, t)
+			}
+			if field.PkgPath != "" {
+				embeddedType := field.Type
+				if embeddedType.Kind() == reflect.Ptr {
+					embeddedType = embeddedType.Elem()
+				}
+				// exported fields of embedded unexported struct are serialized
+				if !field.Anonymous || embeddedType.Kind() != reflect.Struct {
+					return fmt.Errorf("field %s of %s is unexported", field.Name, t)
+				}
+			}
+			if err := checkSerializable(field.Type, checked); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("%s can't be serialized to json", t)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"time"
+)
+
+const (
+	defaultTTL        = time.Minute
+	defaultMaxEntries = 1000
+)
+
+/*
+Rule is cache rule of one method, which can be set by marker '+ioc:aop:cache', like:
+
+	// +ioc:aop:cache:method=GetUser,ttl=1m,maxEntries=100
+
+or by config 'ioc-golang.aop.cache.<sdid>.<method>', which overwrites fields set by marker:
+
+	ioc-golang:
+	  aop:
+	    cache:
+	      github.com/my/app/service.UserService:
+	        GetUser:
+	          ttl: 1m
+	          max-entries: 100
+	          negative-ttl: 5s
+	          store: redis
+
+Params of cached method are serialized to json as cache key, except the context.Context param, and return values are
+serialized too by store like redis. Method whose params or serialized return values don't survive json round trip,
+like types with unexported fields or interfaces, is not cached.
+*/
+type Rule struct {
+	// TTL is expiration of successful results, default 1m
+	TTL time.Duration `yaml:"ttl"`
+	// MaxEntries is max count of entries of method in store that supports it, like memory LRU store, default 1000
+	MaxEntries int `yaml:"max-entries"`
+	// NegativeTTL is expiration of failed results, 0 means failed results are not cached. It is only supported by
+	// store keeping error as it is, like memory store
+	NegativeTTL time.Duration `yaml:"negative-ttl"`
+	// Store is name of store registered by RegisterStore, default is memory
+	Store string `yaml:"store"`
+}
+
+// CacheConfig is config under 'ioc-golang.aop.cache', sdid -> method name -> rule
+type CacheConfig map[string]map[string]*Rule
+
+// merge overwrites fields of r with non-zero fields of overwrite
+func (r *Rule) merge(overwrite *Rule) {
+	if overwrite == nil {
+		return
+	}
+	if overwrite.TTL != 0 {
+		r.TTL = overwrite.TTL
+	}
+	if overwrite.MaxEntries != 0 {
+		r.MaxEntries = overwrite.MaxEntries
+	}
+	if overwrite.NegativeTTL != 0 {
+		r.NegativeTTL = overwrite.NegativeTTL
+	}
+	if overwrite.Store != "" {
+		r.Store = overwrite.Store
+	}
+}
+
+func (r *Rule) fillDefault() {
+	if r.TTL == 0 {
+		r.TTL = defaultTTL
+	}
+	if r.MaxEntries == 0 {
+		r.MaxEntries = defaultMaxEntries
+	}
+	if r.Store == "" {
+		r.Store = MemoryStoreName
+	}
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:proxy=false
+
+/*
+interceptorImpl returns cached results of method if found, keyed by hash of params, otherwise it calls the method
+and caches the results. Failed results are cached only if negative ttl is set. Methods whose params can't be
+serialized to cache key, or whose return values can't be serialized by store like redis, are not cached.
+*/
+type interceptorImpl struct {
+}
+
+func (i *interceptorImpl) Invoke(ctx *aop.InvocationContext, next func() []reflect.Value) []reflect.Value {
+	c := getMethodCache(ctx.SDID, ctx.MethodName)
+	if c == nil {
+		return next()
+	}
+	key, err := hashParams(ctx.Params)
+	if err != nil {
+		logger.Red("[AOP cache] Serialize params of %s.%s() failed, error = %s", ctx.SDID, ctx.MethodName, err)
+		return next()
+	}
+	if returnValues, ok, err := c.store.Get(key); err != nil {
+		logger.Red("[AOP cache] Get cache of %s.%s() failed, error = %s", ctx.SDID, ctx.MethodName, err)
+	} else if ok {
+		c.hit()
+		return returnValues
+	}
+
+	c.miss()
+	returnValues := next()
+	ttl := c.rule.TTL
+	if failed, _ := common.IsInvocationFailed(returnValues); failed || ctx.Panic != nil {
+		ttl = c.rule.NegativeTTL
+	}
+	if ttl <= 0 {
+		return returnValues
+	}
+	if err := c.store.Set(key, returnValues, ttl); err != nil {
+		logger.Red("[AOP cache] Set cache of %s.%s() failed, error = %s", ctx.SDID, ctx.MethodName, err)
+	}
+	return returnValues
+}
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
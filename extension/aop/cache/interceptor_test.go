@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+)
+
+const testSDID = "github.com/alibaba/ioc-golang/extension/aop/cache.testService"
+
+type testUser struct {
+	Name string
+	Age  int
+}
+
+type testService struct {
+}
+
+func (s *testService) GetUser(_ context.Context, name string) (*testUser, error) {
+	return nil, nil
+}
+
+func (s *testService) GetUserByFilter(filter fmt.Stringer) (*testUser, error) {
+	return nil, nil
+}
+
+func init() {
+	autowire.RegisterStructDescriptor(&autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &testService{}
+		},
+	})
+}
+
+func TestInterceptorImpl_Invoke(t *testing.T) {
+	common.SetConfigForTest(t, &cacheConfig, CacheConfig{
+		testSDID: {
+			"GetUser": {
+				TTL:         time.Minute,
+				NegativeTTL: time.Minute,
+			},
+		},
+	}, resetMethodCaches)
+
+	calls := 0
+	getUser := func(_ context.Context, name string) (*testUser, error) {
+		calls++
+		if name == "" {
+			return nil, errors.New("empty name")
+		}
+		return &testUser{Name: name, Age: calls}, nil
+	}
+	interceptor := &interceptorImpl{}
+	invoke := func(methodName string, ctx context.Context, name string) (*testUser, error) {
+		invocationCtx := &aop.InvocationContext{
+			SDID:       testSDID,
+			MethodName: methodName,
+			MethodType: reflect.TypeOf(getUser),
+			Params:     []reflect.Value{reflect.ValueOf(&ctx).Elem(), reflect.ValueOf(name)},
+		}
+		returnValues := interceptor.Invoke(invocationCtx, func() []reflect.Value {
+			return reflect.ValueOf(getUser).Call(invocationCtx.Params)
+		})
+		user, _ := returnValues[0].Interface().(*testUser)
+		err, _ := returnValues[1].Interface().(error)
+		return user, err
+	}
+
+	// context is not a part of cache key
+	user, err := invoke("GetUser", context.Background(), "alice")
+	assert.Nil(t, err)
+	assert.Equal(t, &testUser{Name: "alice", Age: 1}, user)
+	user, err = invoke("GetUser", context.TODO(), "alice")
+	assert.Nil(t, err)
+	assert.Equal(t, &testUser{Name: "alice", Age: 1}, user)
+	assert.Equal(t, 1, calls)
+
+	user, err = invoke("GetUser", context.Background(), "bob")
+	assert.Nil(t, err)
+	assert.Equal(t, &testUser{Name: "bob", Age: 2}, user)
+
+	// failed result is cached with negative ttl, and cached values are kept as they are
+	_, firstErr := invoke("GetUser", context.Background(), "")
+	assert.EqualError(t, firstErr, "empty name")
+	user, err = invoke("GetUser", context.Background(), "")
+	assert.Equal(t, firstErr, err)
+	assert.Nil(t, user)
+	assert.Equal(t, 3, calls)
+
+	// methods not cached are always called
+	_, _ = invoke("NotCached", context.Background(), "alice")
+	_, _ = invoke("NotCached", context.Background(), "alice")
+	assert.Equal(t, 5, calls)
+
+	evictedMethods, err := Evict(testSDID, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GetUser"}, evictedMethods)
+	user, _ = invoke("GetUser", context.Background(), "alice")
+	assert.Equal(t, &testUser{Name: "alice", Age: 6}, user)
+
+	c := getMethodCache(testSDID, "GetUser")
+	assert.Equal(t, int64(2), c.hits)
+	assert.Equal(t, int64(4), c.misses)
+
+	_, err = Evict(testSDID, "NotCached")
+	assert.NotNil(t, err)
+}
+
+func TestGetMethodCache(t *testing.T) {
+	common.SetConfigForTest(t, &cacheConfig, CacheConfig{
+		testSDID: {
+			"GetUser":         {},
+			"GetUserByFilter": {},
+			"NotFound":        {},
+		},
+	}, resetMethodCaches)
+
+	assert.NotNil(t, getMethodCache(testSDID, "GetUser"))
+	// interface param can't be serialized to cache key
+	assert.Nil(t, getMethodCache(testSDID, "GetUserByFilter"))
+	assert.Nil(t, getMethodCache(testSDID, "NotFound"))
+	assert.Nil(t, getMethodCache(testSDID, "NotCached"))
+}
+
+func TestCheckSerializable(t *testing.T) {
+	type embedded struct {
+		Name string
+	}
+	type unexportedField struct {
+		Name string
+		age  int
+	}
+	type ignoredField struct {
+		Name string `json:"-"`
+	}
+	type recursive struct {
+		Children []*recursive
+	}
+	tests := []struct {
+		name    string
+		value   interface{}
+		wantErr bool
+	}{
+		{name: "basic", value: &testUser{}},
+		{name: "collections", value: map[string][]int{}},
+		{name: "time", value: time.Time{}},
+		{name: "embedded unexported struct", value: struct{ embedded }{}},
+		{name: "recursive", value: recursive{}},
+		{name: "unexported field", value: unexportedField{}, wantErr: true},
+		{name: "ignored field", value: ignoredField{}, wantErr: true},
+		{name: "interface", value: []interface{}{}, wantErr: true},
+		{name: "error", value: (*error)(nil), wantErr: true},
+		{name: "func", value: func() {}, wantErr: true},
+		{name: "map with struct key", value: map[testUser]string{}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckSerializable(reflect.TypeOf(tt.value))
+			assert.Equal(t, tt.wantErr, err != nil, err)
+		})
+	}
+}
+
+func TestParseRuleFromSDMetadata(t *testing.T) {
+	sd := &autowire.StructDescriptor{
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{
+				"cache": map[string]map[string]interface{}{
+					"GetUser": {
+						"ttl":          "30s",
+						"max-entries":  100,
+						"negative-ttl": "1s",
+						"store":        "redis",
+					},
+				},
+			},
+		},
+	}
+	assert.Nil(t, parseRuleFromSDMetadata(sd, "NotCached"))
+	assert.Equal(t, &Rule{
+		TTL:         30 * time.Second,
+		MaxEntries:  100,
+		NegativeTTL: time.Second,
+		Store:       "redis",
+	}, parseRuleFromSDMetadata(sd, "GetUser"))
+}
@@ -0,0 +1,205 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+	cachePB "github.com/alibaba/ioc-golang/extension/aop/cache/api/ioc_golang/aop/cache"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// methodCache is cache of one method
+type methodCache struct {
+	sdid   string
+	method string
+	rule   *Rule
+	store  Store
+	hits   int64
+	misses int64
+}
+
+// lenStore is store that supports counting entries
+type lenStore interface {
+	Len() int
+}
+
+func (c *methodCache) describe() *cachePB.CacheStatus {
+	entries := int64(-1)
+	if s, ok := c.store.(lenStore); ok {
+		entries = int64(s.Len())
+	}
+	return &cachePB.CacheStatus{
+		Sdid:        c.sdid,
+		Method:      c.method,
+		Store:       c.rule.Store,
+		Ttl:         c.rule.TTL.String(),
+		NegativeTTL: c.rule.NegativeTTL.String(),
+		Hits:        atomic.LoadInt64(&c.hits),
+		Misses:      atomic.LoadInt64(&c.misses),
+		Entries:     entries,
+	}
+}
+
+func (c *methodCache) hit() {
+	atomic.AddInt64(&c.hits, 1)
+}
+
+func (c *methodCache) miss() {
+	atomic.AddInt64(&c.misses, 1)
+}
+
+var (
+	// methodCaches are caches of methods, nil value means the method is not cached
+	methodCaches     = make(map[string]*methodCache)
+	methodCachesLock sync.Mutex
+)
+
+func resetMethodCaches() {
+	methodCachesLock.Lock()
+	defer methodCachesLock.Unlock()
+	methodCaches = make(map[string]*methodCache)
+}
+
+// getMethodCache returns cache of method set by marker and config, or nil if the method is not cached
+func getMethodCache(sdid, methodName string) *methodCache {
+	key := common.GetMethodUniqueKey(sdid, methodName)
+	methodCachesLock.Lock()
+	defer methodCachesLock.Unlock()
+	if c, ok := methodCaches[key]; ok {
+		return c
+	}
+	c, err := newMethodCache(sdid, methodName)
+	if err != nil {
+		logger.Red("[AOP cache] Create cache of %s.%s() failed, the method is not cached, error = %s",
+			sdid, methodName, err)
+	}
+	methodCaches[key] = c
+	return c
+}
+
+// newMethodCache creates cache of method, nil is returned if the method has no cache rule
+func newMethodCache(sdid, methodName string) (*methodCache, error) {
+	sd := autowire.GetStructDescriptor(sdid)
+	markerRule := parseRuleFromSDMetadata(sd, methodName)
+	configRule := cacheConfig[sdid][methodName]
+	if markerRule == nil && configRule == nil {
+		return nil, nil
+	}
+	rule := &Rule{}
+	rule.merge(markerRule)
+	rule.merge(configRule)
+	rule.fillDefault()
+
+	methodType := getMethodType(sd, methodName)
+	if methodType == nil {
+		return nil, fmt.Errorf("method is not found")
+	}
+	if err := checkParamsSerializable(methodType); err != nil {
+		return nil, err
+	}
+	store, err := newStore(fmt.Sprintf("%s#%s", sdid, methodName), methodType, rule)
+	if err != nil {
+		return nil, err
+	}
+	return &methodCache{
+		sdid:   sdid,
+		method: methodName,
+		rule:   rule,
+		store:  store,
+	}, nil
+}
+
+// getMethodType returns type of method of struct, or nil if it's not found
+func getMethodType(sd *autowire.StructDescriptor, methodName string) reflect.Type {
+	if sd == nil || sd.Factory == nil {
+		return nil
+	}
+	method := reflect.ValueOf(sd.Factory()).MethodByName(methodName)
+	if !method.IsValid() {
+		return nil
+	}
+	return method.Type()
+}
+
+// getSortedMethodCaches returns all created caches of struct sorted by sdid and method, empty sdid returns all
+func getSortedMethodCaches(sdid string) []*methodCache {
+	methodCachesLock.Lock()
+	sortedCaches := make([]*methodCache, 0, len(methodCaches))
+	for _, c := range methodCaches {
+		if c != nil && (sdid == "" || c.sdid == sdid) {
+			sortedCaches = append(sortedCaches, c)
+		}
+	}
+	methodCachesLock.Unlock()
+	sort.Slice(sortedCaches, func(i, j int) bool {
+		if sortedCaches[i].sdid != sortedCaches[j].sdid {
+			return sortedCaches[i].sdid < sortedCaches[j].sdid
+		}
+		return sortedCaches[i].method < sortedCaches[j].method
+	})
+	return sortedCaches
+}
+
+/*
+Evict removes cached results of method, or all cached methods of struct if methodName is empty. It returns the
+evicted methods, and error if the method is not cached.
+*/
+func Evict(sdid, methodName string) ([]string, error) {
+	var caches []*methodCache
+	if methodName != "" {
+		if c := getMethodCache(sdid, methodName); c != nil {
+			caches = append(caches, c)
+		}
+	} else {
+		caches = getSortedMethodCaches(sdid)
+	}
+	if len(caches) == 0 {
+		return nil, fmt.Errorf("cache of %s.%s() is not found", sdid, methodName)
+	}
+	evictedMethods := make([]string, 0, len(caches))
+	for _, c := range caches {
+		if err := c.store.Clear(); err != nil {
+			return evictedMethods, fmt.Errorf("evict cache of %s.%s() failed, error = %s", c.sdid, c.method, err)
+		}
+		evictedMethods = append(evictedMethods, c.method)
+	}
+	return evictedMethods, nil
+}
+
+// parseRuleFromSDMetadata parses rule generated from marker '+ioc:aop:cache', which is like
+// "cache": map[string]map[string]interface{}{"GetUser": {"ttl": "1m"}}
+func parseRuleFromSDMetadata(sd *autowire.StructDescriptor, methodName string) *Rule {
+	if sd == nil {
+		return nil
+	}
+	rule := &Rule{}
+	ok, err := aop.ParseMethodRuleFromSDMetadata(sd.Metadata, Name, methodName, rule)
+	if !ok {
+		return nil
+	}
+	if err != nil {
+		logger.Red("[AOP cache] Invalid cache marker of %s.%s(), error = %s", sd.ID(), methodName, err)
+	}
+	return rule
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	cachePB "github.com/alibaba/ioc-golang/extension/aop/cache/api/ioc_golang/aop/cache"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:paramType=cacheServiceParam
+// +ioc:autowire:constructFunc=Init
+// +ioc:autowire:proxy=false
+
+type cacheService struct {
+	cachePB.UnimplementedCacheServiceServer
+	appName string
+}
+
+type cacheServiceParam struct {
+	AppName string
+}
+
+func (p *cacheServiceParam) Init(s *cacheService) (*cacheService, error) {
+	s.appName = p.AppName
+	return s, nil
+}
+
+func (s *cacheService) List(_ context.Context, _ *emptypb.Empty) (*cachePB.ListCachesResponse, error) {
+	sortedCaches := getSortedMethodCaches("")
+	statuses := make([]*cachePB.CacheStatus, 0, len(sortedCaches))
+	for _, c := range sortedCaches {
+		statuses = append(statuses, c.describe())
+	}
+	return &cachePB.ListCachesResponse{
+		Caches:  statuses,
+		AppName: s.appName,
+	}, nil
+}
+
+func (s *cacheService) Evict(_ context.Context, req *cachePB.EvictCacheRequest) (*cachePB.EvictCacheResponse, error) {
+	evictedMethods, err := Evict(req.GetSdid(), req.GetMethod())
+	if err != nil {
+		return nil, err
+	}
+	logger.Red("[Debug Server] Cache of %s %v is evicted", req.GetSdid(), evictedMethods)
+	return &cachePB.EvictCacheResponse{
+		Methods: evictedMethods,
+	}, nil
+}
@@ -0,0 +1,71 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/alibaba/ioc-golang/aop/common"
+	cachePB "github.com/alibaba/ioc-golang/extension/aop/cache/api/ioc_golang/aop/cache"
+)
+
+func TestCacheService(t *testing.T) {
+	common.SetConfigForTest(t, &cacheConfig, CacheConfig{
+		testSDID: {
+			"GetUser": {
+				TTL: time.Minute,
+			},
+		},
+	}, resetMethodCaches)
+	service, err := GetcacheServiceSingleton(&cacheServiceParam{
+		AppName: "test-app",
+	})
+	assert.Nil(t, err)
+
+	// caches are created when proxy is created
+	c := getMethodCache(testSDID, "GetUser")
+	assert.Nil(t, c.store.Set("key", []reflect.Value{reflect.ValueOf(&testUser{})}, time.Minute))
+
+	rsp, err := service.List(context.TODO(), &emptypb.Empty{})
+	assert.Nil(t, err)
+	assert.Equal(t, "test-app", rsp.GetAppName())
+	assert.Equal(t, 1, len(rsp.GetCaches()))
+	assert.Equal(t, "GetUser", rsp.GetCaches()[0].GetMethod())
+	assert.Equal(t, MemoryStoreName, rsp.GetCaches()[0].GetStore())
+	assert.Equal(t, "1m0s", rsp.GetCaches()[0].GetTtl())
+	assert.Equal(t, int64(1), rsp.GetCaches()[0].GetEntries())
+
+	evictRsp, err := service.Evict(context.TODO(), &cachePB.EvictCacheRequest{
+		Sdid:   testSDID,
+		Method: "GetUser",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"GetUser"}, evictRsp.GetMethods())
+	_, ok, _ := c.store.Get("key")
+	assert.False(t, ok)
+
+	_, err = service.Evict(context.TODO(), &cachePB.EvictCacheRequest{
+		Sdid:   testSDID,
+		Method: "NotCached",
+	})
+	assert.NotNil(t, err)
+}
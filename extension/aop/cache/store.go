@@ -0,0 +1,155 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"container/list"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// Store saves results of one method, which is created by StoreFactory for each cached method
+type Store interface {
+	// Get returns return values of key, and false if it's not found or expired
+	Get(key string) ([]reflect.Value, bool, error)
+	Set(key string, returnValues []reflect.Value, ttl time.Duration) error
+	// Clear removes all entries of the method
+	Clear() error
+}
+
+/*
+StoreFactory creates store of method, namespace is unique for each method, which can be used as prefix of keys.
+Store that serializes return values should return error if they can't survive the round trip, which can be checked by
+CheckSerializable, and then the method is not cached.
+*/
+type StoreFactory func(namespace string, methodType reflect.Type, rule *Rule) (Store, error)
+
+const MemoryStoreName = "memory"
+
+var (
+	storeFactories     = make(map[string]StoreFactory)
+	storeFactoriesLock sync.RWMutex
+)
+
+func init() {
+	RegisterStore(MemoryStoreName, func(_ string, _ reflect.Type, rule *Rule) (Store, error) {
+		return newMemoryStore(rule.MaxEntries), nil
+	})
+}
+
+// RegisterStore registers store factory with name, which can be referred by 'store' of cache rule
+func RegisterStore(name string, factory StoreFactory) {
+	storeFactoriesLock.Lock()
+	defer storeFactoriesLock.Unlock()
+	storeFactories[name] = factory
+}
+
+func newStore(namespace string, methodType reflect.Type, rule *Rule) (Store, error) {
+	storeFactoriesLock.RLock()
+	factory, ok := storeFactories[rule.Store]
+	storeFactoriesLock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("cache store %s is not registered", rule.Store)
+	}
+	return factory(namespace, methodType, rule)
+}
+
+type memoryEntry struct {
+	key          string
+	returnValues []reflect.Value
+	expireAt     time.Time
+}
+
+/*
+memoryStore is LRU store in memory, which removes the least recently used entry if there are more than maxEntries.
+Return values are kept as they are without serialization, so values pointed by them are shared by all callers.
+*/
+type memoryStore struct {
+	lock       sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	lru        *list.List
+}
+
+func newMemoryStore(maxEntries int) *memoryStore {
+	return &memoryStore{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+func (m *memoryStore) Get(key string) ([]reflect.Value, bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	element, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	entry := element.Value.(*memoryEntry)
+	if !time.Now().Before(entry.expireAt) {
+		m.remove(element)
+		return nil, false, nil
+	}
+	m.lru.MoveToFront(element)
+	// copy the slice, which may be modified by interceptors
+	return append(make([]reflect.Value, 0, len(entry.returnValues)), entry.returnValues...), true, nil
+}
+
+func (m *memoryStore) Set(key string, returnValues []reflect.Value, ttl time.Duration) error {
+	returnValues = append(make([]reflect.Value, 0, len(returnValues)), returnValues...)
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	expireAt := time.Now().Add(ttl)
+	if element, ok := m.entries[key]; ok {
+		entry := element.Value.(*memoryEntry)
+		entry.returnValues = returnValues
+		entry.expireAt = expireAt
+		m.lru.MoveToFront(element)
+		return nil
+	}
+	m.entries[key] = m.lru.PushFront(&memoryEntry{
+		key:          key,
+		returnValues: returnValues,
+		expireAt:     expireAt,
+	})
+	for m.maxEntries > 0 && m.lru.Len() > m.maxEntries {
+		m.remove(m.lru.Back())
+	}
+	return nil
+}
+
+func (m *memoryStore) Clear() error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.entries = make(map[string]*list.Element)
+	m.lru.Init()
+	return nil
+}
+
+// Len returns count of entries, including expired ones that are not removed yet
+func (m *memoryStore) Len() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.lru.Len()
+}
+
+func (m *memoryStore) remove(element *list.Element) {
+	m.lru.Remove(element)
+	delete(m.entries, element.Value.(*memoryEntry).key)
+}
@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+)
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// encodeResults serializes return values except the last error to json list, the error must be nil
+func encodeResults(methodType reflect.Type, returnValues []reflect.Value) ([]byte, error) {
+	values := make([]json.RawMessage, 0, len(returnValues))
+	for i, v := range returnValues {
+		if i == len(returnValues)-1 && methodType.Out(i) == errorType {
+			if !v.IsNil() {
+				return nil, errors.New("failed result can't be cached")
+			}
+			continue
+		}
+		data, err := json.Marshal(v.Interface())
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, data)
+	}
+	return json.Marshal(values)
+}
+
+func decodeResults(methodType reflect.Type, data []byte) ([]reflect.Value, error) {
+	values := make([]json.RawMessage, 0)
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+	numOut := methodType.NumOut()
+	returnValues := make([]reflect.Value, numOut)
+	for i := 0; i < numOut; i++ {
+		outType := methodType.Out(i)
+		if i == numOut-1 && outType == errorType {
+			returnValues[i] = reflect.Zero(errorType)
+			continue
+		}
+		if i >= len(values) {
+			return nil, errors.New("count of cached values doesn't match return types of method")
+		}
+		value := reflect.New(outType)
+		if err := json.Unmarshal(values[i], value.Interface()); err != nil {
+			return nil, err
+		}
+		returnValues[i] = value.Elem()
+	}
+	return returnValues, nil
+}
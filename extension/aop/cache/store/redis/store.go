@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+/*
+Package redis registers cache store 'redis' of cache AOP, which saves cached results in redis connected by singleton
+of github.com/alibaba/ioc-golang/extension/state/redis.Redis, whose param is loaded from config. Import it and set
+'store: redis' in cache rule to use it:
+
+	import _ "github.com/alibaba/ioc-golang/extension/aop/cache/store/redis"
+*/
+package redis
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	goRedis "github.com/go-redis/redis"
+
+	"github.com/alibaba/ioc-golang/extension/aop/cache"
+	"github.com/alibaba/ioc-golang/extension/state/redis"
+)
+
+const (
+	Name      = "redis"
+	keyPrefix = "ioc-golang:cache:"
+	scanCount = 100
+)
+
+func init() {
+	cache.RegisterStore(Name, func(namespace string, methodType reflect.Type, rule *cache.Rule) (cache.Store, error) {
+		if err := checkResultsSerializable(methodType, rule); err != nil {
+			return nil, err
+		}
+		redisImpl, err := redis.GetRedisSingleton(nil)
+		if err != nil {
+			return nil, err
+		}
+		return &store{
+			redis:      redisImpl,
+			prefix:     fmt.Sprintf("%s%s:", keyPrefix, namespace),
+			methodType: methodType,
+		}, nil
+	})
+}
+
+// checkResultsSerializable returns error if return values of method can't be saved in redis, error as the last
+// return value is only supported when it's nil, so failed results can't be cached
+func checkResultsSerializable(methodType reflect.Type, rule *cache.Rule) error {
+	for i := 0; i < methodType.NumOut(); i++ {
+		outType := methodType.Out(i)
+		if i == methodType.NumOut()-1 && outType == errorType {
+			if rule.NegativeTTL > 0 {
+				return errors.New("failed results can't be cached by redis store, error is not serializable")
+			}
+			continue
+		}
+		if err := cache.CheckSerializable(outType); err != nil {
+			return fmt.Errorf("return value %d can't be serialized, %s", i, err)
+		}
+	}
+	return nil
+}
+
+// store saves json serialized entries of method with keys prefixed by 'ioc-golang:cache:<sdid>#<method>:'
+type store struct {
+	redis      goRedis.Cmdable
+	prefix     string
+	methodType reflect.Type
+}
+
+func (s *store) Get(key string) ([]reflect.Value, bool, error) {
+	data, err := s.redis.Get(s.prefix + key).Bytes()
+	if err == goRedis.Nil {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	returnValues, err := decodeResults(s.methodType, data)
+	if err != nil {
+		return nil, false, err
+	}
+	return returnValues, true, nil
+}
+
+func (s *store) Set(key string, returnValues []reflect.Value, ttl time.Duration) error {
+	data, err := encodeResults(s.methodType, returnValues)
+	if err != nil {
+		return err
+	}
+	return s.redis.Set(s.prefix+key, data, ttl).Err()
+}
+
+func (s *store) Clear() error {
+	var cursor uint64
+	for {
+		keys, nextCursor, err := s.redis.Scan(cursor, s.prefix+"*", scanCount).Result()
+		if err != nil {
+			return err
+		}
+		if len(keys) > 0 {
+			if err := s.redis.Del(keys...).Err(); err != nil {
+				return err
+			}
+		}
+		if nextCursor == 0 {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
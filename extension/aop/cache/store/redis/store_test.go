@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package redis
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/extension/aop/cache"
+)
+
+type testUser struct {
+	Name     string
+	Birthday time.Time
+}
+
+func TestCheckResultsSerializable(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     interface{}
+		rule       *cache.Rule
+		wantErrStr string
+	}{
+		{
+			name:   "serializable",
+			method: func() (*testUser, []string, error) { return nil, nil, nil },
+			rule:   &cache.Rule{},
+		},
+		{
+			name:       "interface return value",
+			method:     func() (interface{}, error) { return nil, nil },
+			rule:       &cache.Rule{},
+			wantErrStr: "return value 0 can't be serialized, interface {} can't be serialized to json",
+		},
+		{
+			name:       "negative ttl",
+			method:     func() (*testUser, error) { return nil, nil },
+			rule:       &cache.Rule{NegativeTTL: time.Second},
+			wantErrStr: "failed results can't be cached by redis store, error is not serializable",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkResultsSerializable(reflect.TypeOf(tt.method), tt.rule)
+			if tt.wantErrStr == "" {
+				assert.Nil(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.wantErrStr)
+		})
+	}
+}
+
+func TestCodec(t *testing.T) {
+	methodType := reflect.TypeOf(func() (*testUser, error) { return nil, nil })
+	user := &testUser{Name: "alice", Birthday: time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)}
+	data, err := encodeResults(methodType, []reflect.Value{reflect.ValueOf(user), reflect.Zero(errorType)})
+	assert.Nil(t, err)
+	returnValues, err := decodeResults(methodType, data)
+	assert.Nil(t, err)
+	assert.Equal(t, user, returnValues[0].Interface())
+	assert.True(t, returnValues[1].IsNil())
+
+	failedErr := errors.New("failed")
+	_, err = encodeResults(methodType, []reflect.Value{reflect.Zero(methodType.Out(0)), reflect.ValueOf(&failedErr).Elem()})
+	assert.NotNil(t, err)
+}
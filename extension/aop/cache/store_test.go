@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cache
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryStore(t *testing.T) {
+	values := func(v interface{}) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(v)}
+	}
+	store := newMemoryStore(2)
+	user := &testUser{Name: "alice"}
+	assert.Nil(t, store.Set("a", values(user), time.Minute))
+	assert.Nil(t, store.Set("b", values(2), time.Minute))
+
+	// a is used recently, so b is removed when c is set
+	value, ok, err := store.Get("a")
+	assert.Nil(t, err)
+	assert.True(t, ok)
+	assert.True(t, user == value[0].Interface())
+	// modifying returned slice doesn't change cached values
+	value[0] = reflect.ValueOf(&testUser{})
+	value, _, _ = store.Get("a")
+	assert.True(t, user == value[0].Interface())
+	assert.Nil(t, store.Set("c", values(3), time.Minute))
+	_, ok, _ = store.Get("b")
+	assert.False(t, ok)
+	assert.Equal(t, 2, store.Len())
+
+	assert.Nil(t, store.Set("d", values(4), time.Millisecond))
+	time.Sleep(time.Millisecond * 5)
+	_, ok, _ = store.Get("d")
+	assert.False(t, ok)
+
+	assert.Nil(t, store.Clear())
+	assert.Equal(t, 0, store.Len())
+	_, ok, _ = store.Get("c")
+	assert.False(t, ok)
+}
+
+func TestNewStore(t *testing.T) {
+	methodType := reflect.TypeOf((&testService{}).GetUser)
+	_, err := newStore("test", methodType, &Rule{Store: "not-registered"})
+	assert.NotNil(t, err)
+
+	store, err := newStore("test", methodType, &Rule{Store: MemoryStoreName, MaxEntries: 10})
+	assert.Nil(t, err)
+	assert.Equal(t, 10, store.(*memoryStore).maxEntries)
+}
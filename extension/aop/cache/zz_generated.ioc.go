@@ -0,0 +1,78 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by iocli, run 'iocli gen' to re-generate
+
+package cache
+
+import (
+	autowire "github.com/alibaba/ioc-golang/autowire"
+	singleton "github.com/alibaba/ioc-golang/autowire/singleton"
+	util "github.com/alibaba/ioc-golang/autowire/util"
+)
+
+func init() {
+	interceptorImplStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &interceptorImpl{}
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	singleton.RegisterStructDescriptor(interceptorImplStructDescriptor)
+	cacheServiceStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &cacheService{}
+		},
+		ParamFactory: func() interface{} {
+			var _ cacheServiceParamInterface = &cacheServiceParam{}
+			return &cacheServiceParam{}
+		},
+		ConstructFunc: func(i interface{}, p interface{}) (interface{}, error) {
+			param := p.(cacheServiceParamInterface)
+			impl := i.(*cacheService)
+			return param.Init(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	singleton.RegisterStructDescriptor(cacheServiceStructDescriptor)
+}
+
+type cacheServiceParamInterface interface {
+	Init(impl *cacheService) (*cacheService, error)
+}
+
+var _interceptorImplSDID string
+
+func GetinterceptorImplSingleton() (*interceptorImpl, error) {
+	if _interceptorImplSDID == "" {
+		_interceptorImplSDID = util.GetSDIDByStructPtr(new(interceptorImpl))
+	}
+	i, err := singleton.GetImpl(_interceptorImplSDID, nil)
+	if err != nil {
+		return nil, err
+	}
+	impl := i.(*interceptorImpl)
+	return impl, nil
+}
+
+var _cacheServiceSDID string
+
+func GetcacheServiceSingleton(p *cacheServiceParam) (*cacheService, error) {
+	if _cacheServiceSDID == "" {
+		_cacheServiceSDID = util.GetSDIDByStructPtr(new(cacheService))
+	}
+	i, err := singleton.GetImpl(_cacheServiceSDID, p)
+	if err != nil {
+		return nil, err
+	}
+	impl := i.(*cacheService)
+	return impl, nil
+}
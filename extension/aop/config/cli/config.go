@@ -0,0 +1,89 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	configPB "github.com/alibaba/ioc-golang/extension/aop/config/api/ioc_golang/aop/config"
+	"github.com/alibaba/ioc-golang/iocli/root"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+func getConfigServiceClient(addr string) configPB.ConfigServiceClient {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	return configPB.NewConfigServiceClient(conn)
+}
+
+var configCommand = &cobra.Command{
+	Use:   "config",
+	Short: "Inspect effective config of application",
+	Long:  "Inspect effective config of application",
+}
+
+var getCommand = &cobra.Command{
+	Use:   "get [prefix]",
+	Short: "Get effective config properties with origins, under given prefix",
+	Long:  "Get effective config properties with origins, under given prefix",
+	Example: `  iocli config get
+  iocli config get autowire.normal.<github.com/alibaba/ioc-golang/extension/state/redis.Redis>.param`,
+	Run: func(cmd *cobra.Command, args []string) {
+		prefix := ""
+		if len(args) > 0 {
+			prefix = args[0]
+		}
+		configServiceClient := getConfigServiceClient(fmt.Sprintf("%s:%d", debugHost, debugPort))
+		rsp, err := configServiceClient.Get(context.Background(), &configPB.GetConfigRequest{
+			Prefix: prefix,
+		})
+		if err != nil {
+			logger.Red(err.Error())
+			return
+		}
+		if rsp.AppName != "" {
+			logger.Blue("appName: %s", rsp.GetAppName())
+		}
+		logger.Blue("activeProfiles: %s", rsp.GetActiveProfiles())
+		for _, p := range rsp.Properties {
+			origin := p.GetOrigin()
+			if origin == "" {
+				origin = "unknown"
+			}
+			logger.Cyan("%s = %s", p.GetPath(), p.GetValue())
+			logger.Blue("  from %s", origin)
+		}
+	},
+}
+
+var (
+	debugHost string
+	debugPort int
+)
+
+func init() {
+	root.Cmd.AddCommand(configCommand)
+	configCommand.AddCommand(getCommand)
+	configCommand.PersistentFlags().IntVarP(&debugPort, "port", "p", 1999, "debug port")
+	configCommand.PersistentFlags().StringVar(&debugHost, "host", "127.0.0.1", "debug host")
+}
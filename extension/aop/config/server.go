@@ -0,0 +1,68 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"context"
+
+	iocConfig "github.com/alibaba/ioc-golang/config"
+	"github.com/alibaba/ioc-golang/extension/aop/config/api/ioc_golang/aop/config"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:paramType=configServiceImplParam
+// +ioc:autowire:constructFunc=Init
+// +ioc:autowire:proxy=false
+
+type configServiceImpl struct {
+	config.UnimplementedConfigServiceServer
+	appName string
+}
+
+type configServiceImplParam struct {
+	AppName string
+}
+
+func (p *configServiceImplParam) Init(i *configServiceImpl) (*configServiceImpl, error) {
+	i.appName = p.AppName
+	return i, nil
+}
+
+// Get returns effective config properties under request prefix, with origin of each property, sensitive values are
+// masked.
+func (c *configServiceImpl) Get(_ context.Context, request *config.GetConfigRequest) (*config.GetConfigResponse, error) {
+	properties, err := iocConfig.GetProperties(request.GetPrefix())
+	if err != nil {
+		logger.Red("[AOP config] Get config with prefix %s failed with error = %s", request.GetPrefix(), err)
+		return nil, err
+	}
+	rsp := &config.GetConfigResponse{
+		Properties:     make([]*config.ConfigProperty, 0, len(properties)),
+		AppName:        c.appName,
+		ActiveProfiles: iocConfig.GetActiveProfiles(),
+	}
+	for _, p := range properties {
+		rsp.Properties = append(rsp.Properties, &config.ConfigProperty{
+			Path:   p.Path,
+			Value:  p.Value,
+			Origin: p.Origin,
+			Masked: p.Masked,
+		})
+	}
+	return rsp, nil
+}
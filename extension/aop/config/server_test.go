@@ -0,0 +1,57 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	iocConfig "github.com/alibaba/ioc-golang/config"
+	"github.com/alibaba/ioc-golang/extension/aop/config/api/ioc_golang/aop/config"
+)
+
+func TestConfigServiceImpl(t *testing.T) {
+	assert.Nil(t, iocConfig.Load(
+		iocConfig.AddProperty("autowire.normal.<github.com/alibaba/ioc-golang/test.Impl>.param.address", "localhost:6379"),
+		iocConfig.AddProperty("autowire.normal.<github.com/alibaba/ioc-golang/test.Impl>.param.password", "my-password"),
+	))
+	mockService, err := GetconfigServiceImplSingleton(&configServiceImplParam{
+		AppName: "test-app",
+	})
+	assert.Nil(t, err)
+
+	rsp, err := mockService.Get(context.TODO(), &config.GetConfigRequest{
+		Prefix: "autowire.normal.<github.com/alibaba/ioc-golang/test.Impl>.param",
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, "test-app", rsp.GetAppName())
+	properties := rsp.GetProperties()
+	assert.Equal(t, 2, len(properties))
+	assert.Equal(t, "autowire.normal.<github.com/alibaba/ioc-golang/test.Impl>.param.address", properties[0].Path)
+	assert.Equal(t, "localhost:6379", properties[0].Value)
+	assert.Equal(t, iocConfig.OriginAPI, properties[0].Origin)
+	assert.False(t, properties[0].Masked)
+	assert.Equal(t, "autowire.normal.<github.com/alibaba/ioc-golang/test.Impl>.param.password", properties[1].Path)
+	assert.Equal(t, iocConfig.MaskedValue, properties[1].Value)
+	assert.True(t, properties[1].Masked)
+
+	_, err = mockService.Get(context.TODO(), &config.GetConfigRequest{
+		Prefix: "autowire.notFound",
+	})
+	assert.NotNil(t, err)
+}
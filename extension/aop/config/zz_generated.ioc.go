@@ -0,0 +1,53 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by iocli, run 'iocli gen' to re-generate
+
+package config
+
+import (
+	autowire "github.com/alibaba/ioc-golang/autowire"
+	singleton "github.com/alibaba/ioc-golang/autowire/singleton"
+	util "github.com/alibaba/ioc-golang/autowire/util"
+)
+
+func init() {
+	configServiceImplStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &configServiceImpl{}
+		},
+		ParamFactory: func() interface{} {
+			var _ configServiceImplParamInterface = &configServiceImplParam{}
+			return &configServiceImplParam{}
+		},
+		ConstructFunc: func(i interface{}, p interface{}) (interface{}, error) {
+			param := p.(configServiceImplParamInterface)
+			impl := i.(*configServiceImpl)
+			return param.Init(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	singleton.RegisterStructDescriptor(configServiceImplStructDescriptor)
+}
+
+type configServiceImplParamInterface interface {
+	Init(impl *configServiceImpl) (*configServiceImpl, error)
+}
+
+var _configServiceImplSDID string
+
+func GetconfigServiceImplSingleton(p *configServiceImplParam) (*configServiceImpl, error) {
+	if _configServiceImplSDID == "" {
+		_configServiceImplSDID = util.GetSDIDByStructPtr(new(configServiceImpl))
+	}
+	i, err := singleton.GetImpl(_configServiceImplSDID, p)
+	if err != nil {
+		return nil, err
+	}
+	impl := i.(*configServiceImpl)
+	return impl, nil
+}
@@ -0,0 +1,440 @@
+// EDIT IT, change to your package, service and message
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.26.0
+// 	protoc        v3.14.0
+// source: extension/aop/limit/api/ioc_golang/aop/limit/limit.proto
+
+package limit
+
+import (
+	reflect "reflect"
+	sync "sync"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListLimitsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Limits  []*LimitStatus `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"`
+	AppName string         `protobuf:"bytes,2,opt,name=appName,proto3" json:"appName,omitempty"`
+}
+
+func (x *ListLimitsResponse) Reset() {
+	*x = ListLimitsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLimitsResponse) ProtoMessage() {}
+
+func (x *ListLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLimitsResponse.ProtoReflect.Descriptor instead.
+func (*ListLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListLimitsResponse) GetLimits() []*LimitStatus {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *ListLimitsResponse) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+type LimitStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sdid   string `protobuf:"bytes,1,opt,name=sdid,proto3" json:"sdid,omitempty"`
+	Method string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	// rate is permits per second of token bucket, 0 means no rate limit
+	Rate  float64 `protobuf:"fixed64,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Burst int64   `protobuf:"varint,4,opt,name=burst,proto3" json:"burst,omitempty"`
+	// maxConcurrent is max count of concurrent calls, 0 means no bulkhead
+	MaxConcurrent int64 `protobuf:"varint,5,opt,name=maxConcurrent,proto3" json:"maxConcurrent,omitempty"`
+	// concurrent is count of calls in progress
+	Concurrent int64 `protobuf:"varint,6,opt,name=concurrent,proto3" json:"concurrent,omitempty"`
+	// passed and rejected are counts of calls since the limiter is created
+	Passed   int64 `protobuf:"varint,7,opt,name=passed,proto3" json:"passed,omitempty"`
+	Rejected int64 `protobuf:"varint,8,opt,name=rejected,proto3" json:"rejected,omitempty"`
+	Block    bool  `protobuf:"varint,9,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *LimitStatus) Reset() {
+	*x = LimitStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LimitStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LimitStatus) ProtoMessage() {}
+
+func (x *LimitStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LimitStatus.ProtoReflect.Descriptor instead.
+func (*LimitStatus) Descriptor() ([]byte, []int) {
+	return file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LimitStatus) GetSdid() string {
+	if x != nil {
+		return x.Sdid
+	}
+	return ""
+}
+
+func (x *LimitStatus) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *LimitStatus) GetRate() float64 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *LimitStatus) GetBurst() int64 {
+	if x != nil {
+		return x.Burst
+	}
+	return 0
+}
+
+func (x *LimitStatus) GetMaxConcurrent() int64 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *LimitStatus) GetConcurrent() int64 {
+	if x != nil {
+		return x.Concurrent
+	}
+	return 0
+}
+
+func (x *LimitStatus) GetPassed() int64 {
+	if x != nil {
+		return x.Passed
+	}
+	return 0
+}
+
+func (x *LimitStatus) GetRejected() int64 {
+	if x != nil {
+		return x.Rejected
+	}
+	return 0
+}
+
+func (x *LimitStatus) GetBlock() bool {
+	if x != nil {
+		return x.Block
+	}
+	return false
+}
+
+// SetLimitRequest changes limits of method, fields not set keep unchanged
+type SetLimitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sdid          string                  `protobuf:"bytes,1,opt,name=sdid,proto3" json:"sdid,omitempty"`
+	Method        string                  `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Rate          *wrapperspb.DoubleValue `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Burst         *wrapperspb.Int64Value  `protobuf:"bytes,4,opt,name=burst,proto3" json:"burst,omitempty"`
+	MaxConcurrent *wrapperspb.Int64Value  `protobuf:"bytes,5,opt,name=maxConcurrent,proto3" json:"maxConcurrent,omitempty"`
+}
+
+func (x *SetLimitRequest) Reset() {
+	*x = SetLimitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLimitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLimitRequest) ProtoMessage() {}
+
+func (x *SetLimitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLimitRequest.ProtoReflect.Descriptor instead.
+func (*SetLimitRequest) Descriptor() ([]byte, []int) {
+	return file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetLimitRequest) GetSdid() string {
+	if x != nil {
+		return x.Sdid
+	}
+	return ""
+}
+
+func (x *SetLimitRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *SetLimitRequest) GetRate() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *SetLimitRequest) GetBurst() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.Burst
+	}
+	return nil
+}
+
+func (x *SetLimitRequest) GetMaxConcurrent() *wrapperspb.Int64Value {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return nil
+}
+
+var File_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto protoreflect.FileDescriptor
+
+var file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDesc = []byte{
+	0x0a, 0x38, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x2f, 0x61, 0x6f, 0x70, 0x2f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f,
+	0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61, 0x6f, 0x70, 0x2f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2f, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x14, 0x69, 0x6f, 0x63, 0x5f,
+	0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77,
+	0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x69, 0x0a,
+	0x12, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67,
+	0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xf3, 0x01, 0x0a, 0x0b, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x64, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x64, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x12, 0x24,
+	0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xe5,
+	0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x64, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x64, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x30,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x6f, 0x75, 0x62, 0x6c, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65,
+	0x12, 0x31, 0x0a, 0x05, 0x62, 0x75, 0x72, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x62, 0x75,
+	0x72, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74,
+	0x36, 0x34, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x32, 0xad, 0x01, 0x0a, 0x0c, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f,
+	0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x69, 0x6f, 0x63,
+	0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61,
+	0x6f, 0x70, 0x2e, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x42, 0x16, 0x5a, 0x14, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f,
+	0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61, 0x6f, 0x70, 0x2f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescOnce sync.Once
+	file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescData = file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDesc
+)
+
+func file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescGZIP() []byte {
+	file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescOnce.Do(func() {
+		file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescData = protoimpl.X.CompressGZIP(file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescData)
+	})
+	return file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDescData
+}
+
+var file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_goTypes = []interface{}{
+	(*ListLimitsResponse)(nil),     // 0: ioc_golang.aop.limit.ListLimitsResponse
+	(*LimitStatus)(nil),            // 1: ioc_golang.aop.limit.LimitStatus
+	(*SetLimitRequest)(nil),        // 2: ioc_golang.aop.limit.SetLimitRequest
+	(*wrapperspb.DoubleValue)(nil), // 3: google.protobuf.DoubleValue
+	(*wrapperspb.Int64Value)(nil),  // 4: google.protobuf.Int64Value
+	(*emptypb.Empty)(nil),          // 5: google.protobuf.Empty
+}
+var file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_depIdxs = []int32{
+	1, // 0: ioc_golang.aop.limit.ListLimitsResponse.limits:type_name -> ioc_golang.aop.limit.LimitStatus
+	3, // 1: ioc_golang.aop.limit.SetLimitRequest.rate:type_name -> google.protobuf.DoubleValue
+	4, // 2: ioc_golang.aop.limit.SetLimitRequest.burst:type_name -> google.protobuf.Int64Value
+	4, // 3: ioc_golang.aop.limit.SetLimitRequest.maxConcurrent:type_name -> google.protobuf.Int64Value
+	5, // 4: ioc_golang.aop.limit.LimitService.List:input_type -> google.protobuf.Empty
+	2, // 5: ioc_golang.aop.limit.LimitService.Set:input_type -> ioc_golang.aop.limit.SetLimitRequest
+	0, // 6: ioc_golang.aop.limit.LimitService.List:output_type -> ioc_golang.aop.limit.ListLimitsResponse
+	1, // 7: ioc_golang.aop.limit.LimitService.Set:output_type -> ioc_golang.aop.limit.LimitStatus
+	6, // [6:8] is the sub-list for method output_type
+	4, // [4:6] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_init() }
+func file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_init() {
+	if File_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListLimitsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LimitStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLimitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_goTypes,
+		DependencyIndexes: file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_depIdxs,
+		MessageInfos:      file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_msgTypes,
+	}.Build()
+	File_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto = out.File
+	file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_rawDesc = nil
+	file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_goTypes = nil
+	file_extension_aop_limit_api_ioc_golang_aop_limit_limit_proto_depIdxs = nil
+}
@@ -0,0 +1,139 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package limit
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// LimitServiceClient is the client API for LimitService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LimitServiceClient interface {
+	List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListLimitsResponse, error)
+	Set(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*LimitStatus, error)
+}
+
+type limitServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLimitServiceClient(cc grpc.ClientConnInterface) LimitServiceClient {
+	return &limitServiceClient{cc}
+}
+
+func (c *limitServiceClient) List(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListLimitsResponse, error) {
+	out := new(ListLimitsResponse)
+	err := c.cc.Invoke(ctx, "/ioc_golang.aop.limit.LimitService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *limitServiceClient) Set(ctx context.Context, in *SetLimitRequest, opts ...grpc.CallOption) (*LimitStatus, error) {
+	out := new(LimitStatus)
+	err := c.cc.Invoke(ctx, "/ioc_golang.aop.limit.LimitService/Set", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LimitServiceServer is the server API for LimitService service.
+// All implementations must embed UnimplementedLimitServiceServer
+// for forward compatibility
+type LimitServiceServer interface {
+	List(context.Context, *emptypb.Empty) (*ListLimitsResponse, error)
+	Set(context.Context, *SetLimitRequest) (*LimitStatus, error)
+	mustEmbedUnimplementedLimitServiceServer()
+}
+
+// UnimplementedLimitServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedLimitServiceServer struct {
+}
+
+func (UnimplementedLimitServiceServer) List(context.Context, *emptypb.Empty) (*ListLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedLimitServiceServer) Set(context.Context, *SetLimitRequest) (*LimitStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Set not implemented")
+}
+func (UnimplementedLimitServiceServer) mustEmbedUnimplementedLimitServiceServer() {}
+
+// UnsafeLimitServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LimitServiceServer will
+// result in compilation errors.
+type UnsafeLimitServiceServer interface {
+	mustEmbedUnimplementedLimitServiceServer()
+}
+
+func RegisterLimitServiceServer(s grpc.ServiceRegistrar, srv LimitServiceServer) {
+	s.RegisterService(&LimitService_ServiceDesc, srv)
+}
+
+func _LimitService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LimitServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ioc_golang.aop.limit.LimitService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LimitServiceServer).List(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LimitService_Set_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LimitServiceServer).Set(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ioc_golang.aop.limit.LimitService/Set",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LimitServiceServer).Set(ctx, req.(*SetLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LimitService_ServiceDesc is the grpc.ServiceDesc for LimitService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LimitService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ioc_golang.aop.limit.LimitService",
+	HandlerType: (*LimitServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _LimitService_List_Handler,
+		},
+		{
+			MethodName: "Set",
+			Handler:    _LimitService_Set_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "extension/aop/limit/api/ioc_golang/aop/limit/limit.proto",
+}
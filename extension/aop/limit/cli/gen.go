@@ -0,0 +1,46 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"sigs.k8s.io/controller-tools/pkg/markers"
+
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin/common"
+)
+
+const limitAnnotation = "ioc:aop:limit"
+
+// +ioc:autowire=true
+// +ioc:autowire:type=allimpls
+// +ioc:autowire:proxy=false
+// +ioc:autowire:implements=github.com/alibaba/ioc-golang/iocli/gen/marker.DefinitionGetter
+
+type limitMarker struct {
+}
+
+func (m *limitMarker) GetMarkerDefinition() *markers.Definition {
+	return common.NewMethodMarkerDefinition(limitAnnotation, limitMarkerArgs{})
+}
+
+// limitMarkerArgs is args of marker '+ioc:aop:limit:method=GetUser,rate=100,burst=20,maxConcurrent=10'
+type limitMarkerArgs struct {
+	Method        string  `marker:"method"`
+	Rate          float64 `marker:"rate,optional" rule:"rate"`
+	Burst         int     `marker:"burst,optional" rule:"burst"`
+	MaxConcurrent int     `marker:"maxConcurrent,optional" rule:"max-concurrent"`
+	Block         bool    `marker:"block,optional" rule:"block"`
+	MaxWait       string  `marker:"maxWait,optional" rule:"max-wait"`
+}
@@ -0,0 +1,116 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	limitPB "github.com/alibaba/ioc-golang/extension/aop/limit/api/ioc_golang/aop/limit"
+	"github.com/alibaba/ioc-golang/iocli/root"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+func getLimitServiceClient(addr string) limitPB.LimitServiceClient {
+	conn, err := grpc.Dial(addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		panic(err)
+	}
+	return limitPB.NewLimitServiceClient(conn)
+}
+
+var limitCommand = &cobra.Command{
+	Use:   "limit",
+	Short: "List rate limits and bulkheads of application, and change them at runtime",
+	Long:  "List rate limits and bulkheads of application, and change them at runtime",
+	Run: func(cmd *cobra.Command, args []string) {
+		limitServiceClient := getLimitServiceClient(fmt.Sprintf("%s:%d", debugHost, debugPort))
+		rsp, err := limitServiceClient.List(context.Background(), &emptypb.Empty{})
+		if err != nil {
+			logger.Red(err.Error())
+			return
+		}
+		if rsp.AppName != "" {
+			logger.Blue("appName: %s", rsp.GetAppName())
+		}
+		for _, status := range rsp.Limits {
+			printLimitStatus(status)
+		}
+	},
+}
+
+var setCommand = &cobra.Command{
+	Use:   "set [sdid] [method]",
+	Short: "Change rate limit or bulkhead of method, flags not set keep unchanged, 0 removes the limit",
+	Long:  "Change rate limit or bulkhead of method, flags not set keep unchanged, 0 removes the limit",
+	Example: `  iocli limit set github.com/my/app/service.UserService GetUser --rate 10
+  iocli limit set github.com/my/app/service.UserService GetUser --rate 100 --burst 20 --max-concurrent 5`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		req := &limitPB.SetLimitRequest{
+			Sdid:   args[0],
+			Method: args[1],
+		}
+		if cmd.Flags().Changed("rate") {
+			req.Rate = wrapperspb.Double(rate)
+		}
+		if cmd.Flags().Changed("burst") {
+			req.Burst = wrapperspb.Int64(burst)
+		}
+		if cmd.Flags().Changed("max-concurrent") {
+			req.MaxConcurrent = wrapperspb.Int64(maxConcurrent)
+		}
+		limitServiceClient := getLimitServiceClient(fmt.Sprintf("%s:%d", debugHost, debugPort))
+		status, err := limitServiceClient.Set(context.Background(), req)
+		if err != nil {
+			logger.Red(err.Error())
+			return
+		}
+		printLimitStatus(status)
+	},
+}
+
+func printLimitStatus(status *limitPB.LimitStatus) {
+	logger.Blue("%s.%s()", status.GetSdid(), status.GetMethod())
+	logger.Cyan("  Rate: %.2f/s, Burst: %d, MaxConcurrent: %d, Block: %t", status.GetRate(), status.GetBurst(),
+		status.GetMaxConcurrent(), status.GetBlock())
+	logger.Blue("  Concurrent: %d, Passed: %d, Rejected: %d", status.GetConcurrent(), status.GetPassed(),
+		status.GetRejected())
+}
+
+var (
+	debugHost     string
+	debugPort     int
+	rate          float64
+	burst         int64
+	maxConcurrent int64
+)
+
+func init() {
+	root.Cmd.AddCommand(limitCommand)
+	limitCommand.AddCommand(setCommand)
+	limitCommand.PersistentFlags().IntVarP(&debugPort, "port", "p", 1999, "debug port")
+	limitCommand.PersistentFlags().StringVar(&debugHost, "host", "127.0.0.1", "debug host")
+	setCommand.Flags().Float64Var(&rate, "rate", 0, "permits per second, 0 removes rate limit")
+	setCommand.Flags().Int64Var(&burst, "burst", 0, "capacity of token bucket")
+	setCommand.Flags().Int64Var(&maxConcurrent, "max-concurrent", 0, "max count of concurrent calls, 0 removes bulkhead")
+}
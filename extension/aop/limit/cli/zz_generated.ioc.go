@@ -0,0 +1,65 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by iocli, run 'iocli gen' to re-generate
+
+package cli
+
+import (
+	autowire "github.com/alibaba/ioc-golang/autowire"
+	allimpls "github.com/alibaba/ioc-golang/extension/autowire/allimpls"
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin"
+	marker "github.com/alibaba/ioc-golang/iocli/gen/marker"
+)
+
+func init() {
+	limitMarkerStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &limitMarker{}
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(marker.DefinitionGetter),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(limitMarkerStructDescriptor)
+	var _ marker.DefinitionGetter = &limitMarker{}
+	limitCodeGenerationPluginStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &limitCodeGenerationPlugin{}
+		},
+		ConstructFunc: func(i interface{}, _ interface{}) (interface{}, error) {
+			impl := i.(*limitCodeGenerationPlugin)
+			var constructFunc limitCodeGenerationPluginConstructFunc = create
+			return constructFunc(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"allimpls": map[string]interface{}{
+					"autowireType": "normal",
+				},
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(plugin.CodeGeneratorPluginForOneStruct),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(limitCodeGenerationPluginStructDescriptor)
+	var _ plugin.CodeGeneratorPluginForOneStruct = &limitCodeGenerationPlugin{}
+}
+
+type limitCodeGenerationPluginConstructFunc func(impl *limitCodeGenerationPlugin) (*limitCodeGenerationPlugin, error)
+
+var _limitMarkerSDID string
+var _limitCodeGenerationPluginSDID string
@@ -0,0 +1,66 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limit
+
+import (
+	"math"
+	"time"
+)
+
+/*
+Rule is rate limit and bulkhead of one method, which can be set by marker '+ioc:aop:limit', like:
+
+	// +ioc:aop:limit:method=GetUser,rate=100,burst=20,maxConcurrent=10
+
+or by config 'ioc-golang.aop.limit.<sdid>.<method>', which overwrites fields set by marker, including zero values
+like 'block: false' and 'rate: 0':
+
+	ioc-golang:
+	  aop:
+	    limit:
+	      github.com/my/app/service.UserService:
+	        GetUser:
+	          rate: 100
+	          burst: 20
+	          max-concurrent: 10
+	          block: true
+	          max-wait: 500ms
+
+Limits can be changed at runtime by 'iocli limit set'.
+*/
+type Rule struct {
+	// Rate is permits per second of token bucket, 0 means no rate limit
+	Rate float64 `yaml:"rate"`
+	// Burst is capacity of token bucket, default is Rate rounded up
+	Burst int `yaml:"burst"`
+	// MaxConcurrent is max count of concurrent calls, 0 means no bulkhead
+	MaxConcurrent int `yaml:"max-concurrent"`
+	// Block makes calls exceeding limits wait for permit, instead of failing fast with *RejectedError
+	Block bool `yaml:"block"`
+	// MaxWait is max duration that blocked call waits for, 0 means waiting until context.Context param is done
+	MaxWait time.Duration `yaml:"max-wait"`
+}
+
+// LimitConfig is config under 'ioc-golang.aop.limit', sdid -> method name -> configured fields of rule, which are
+// kept as raw values, so that only configured fields overwrite the rule
+type LimitConfig map[string]map[string]map[string]interface{}
+
+func getDefaultBurst(rate float64) int {
+	if rate <= 1 {
+		return 1
+	}
+	return int(math.Ceil(rate))
+}
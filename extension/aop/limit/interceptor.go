@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limit
+
+import (
+	"reflect"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:proxy=false
+
+/*
+interceptorImpl applies rate limit and bulkhead to calls of limited method. Calls exceeding limits return
+*RejectedError as the last return value, or zero values with rejection logged if method has no error return, or wait for permit if the rule blocks, until max wait passes or
+context.Context param is done.
+*/
+type interceptorImpl struct {
+}
+
+func (i *interceptorImpl) Invoke(ctx *aop.InvocationContext, next func() []reflect.Value) []reflect.Value {
+	limiter := getLimiter(ctx.SDID, ctx.MethodName)
+	if limiter == nil {
+		return next()
+	}
+	release, err := limiter.acquire(ctx.Context)
+	if err != nil {
+		if !ctx.ReturnsError() {
+			// rejection is counted by limiter, and logged as caller gets zero values without error
+			logger.Red("[AOP limit] %s, zero values are returned as the method has no error return", err)
+		}
+		return ctx.ReturnValuesWithError(err)
+	}
+	defer release()
+	return next()
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limit
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/autowire/singleton"
+)
+
+const testSDID = "github.com/alibaba/ioc-golang/extension/aop/limit.testService"
+
+func TestInterceptorImpl_Invoke(t *testing.T) {
+	common.SetConfigForTest(t, &limitConfig, LimitConfig{
+		testSDID: {
+			"Call": {
+				"rate":  1,
+				"burst": 2,
+			},
+		},
+	}, resetLimiters)
+
+	methodType := reflect.TypeOf(func() error { return nil })
+	interceptor := &interceptorImpl{}
+	invoke := func(methodName string) error {
+		ctx := &aop.InvocationContext{
+			SDID:       testSDID,
+			MethodName: methodName,
+			MethodType: methodType,
+		}
+		returnValues := interceptor.Invoke(ctx, func() []reflect.Value {
+			return ctx.ReturnValuesWithError(nil)
+		})
+		if returnValues[0].IsNil() {
+			return nil
+		}
+		return returnValues[0].Interface().(error)
+	}
+
+	assert.Nil(t, invoke("Call"))
+	assert.Nil(t, invoke("Call"))
+	err := invoke("Call")
+	var rejectedErr *RejectedError
+	assert.True(t, errors.As(err, &rejectedErr))
+	assert.Equal(t, ReasonRateLimit, rejectedErr.Reason)
+
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, invoke("NotLimited"))
+	}
+}
+
+func TestParseRuleFromSDMetadata(t *testing.T) {
+	sd := &autowire.StructDescriptor{
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{
+				"limit": map[string]map[string]interface{}{
+					"Call": {
+						"rate":           100,
+						"max-concurrent": 5,
+						"block":          true,
+						"max-wait":       "500ms",
+					},
+				},
+			},
+		},
+	}
+	assert.False(t, parseRuleFromSDMetadata(sd, "NotLimited", &Rule{}))
+	rule := &Rule{}
+	assert.True(t, parseRuleFromSDMetadata(sd, "Call", rule))
+	assert.Equal(t, &Rule{
+		Rate:          100,
+		MaxConcurrent: 5,
+		Block:         true,
+		MaxWait:       500 * time.Millisecond,
+	}, rule)
+}
+
+type markedService struct {
+}
+
+func TestGetLimiter(t *testing.T) {
+	sd := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &markedService{}
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{
+				"limit": map[string]map[string]interface{}{
+					"Call": {
+						"rate":           100,
+						"max-concurrent": 5,
+						"block":          true,
+					},
+				},
+			},
+		},
+	}
+	singleton.RegisterStructDescriptor(sd)
+	common.SetConfigForTest(t, &limitConfig, LimitConfig{
+		sd.ID(): {
+			"Call": {
+				"rate":  0,
+				"block": false,
+			},
+		},
+	}, resetLimiters)
+
+	// config overwrites marker with zero values
+	status := getLimiter(sd.ID(), "Call").describe()
+	assert.Equal(t, float64(0), status.Rate)
+	assert.Equal(t, int64(5), status.MaxConcurrent)
+	assert.False(t, status.Block)
+	assert.Nil(t, getLimiter(sd.ID(), "NotLimited"))
+}
@@ -0,0 +1,291 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limit
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	limitPB "github.com/alibaba/ioc-golang/extension/aop/limit/api/ioc_golang/aop/limit"
+)
+
+const (
+	ReasonRateLimit = "rate limit exceeded"
+	ReasonBulkhead  = "max concurrent calls exceeded"
+)
+
+// RejectedError is returned by calls rejected by rate limit or bulkhead
+type RejectedError struct {
+	SDID   string
+	Method string
+	Reason string
+}
+
+func (e *RejectedError) Error() string {
+	return fmt.Sprintf("call of %s.%s() is rejected, %s", e.SDID, e.Method, e.Reason)
+}
+
+// tokenBucket permits rate calls per second, with at most burst calls at once
+type tokenBucket struct {
+	lock   sync.Mutex
+	rate   float64
+	burst  int
+	tokens float64
+	last   time.Time
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{
+		rate:   rate,
+		burst:  burst,
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if b.rate > 0 {
+		b.tokens = math.Min(float64(b.burst), b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+	b.last = now
+}
+
+// take returns true if a token is taken, if block is true, it waits for the token until ctx is done
+func (b *tokenBucket) take(ctx context.Context, block bool) bool {
+	b.lock.Lock()
+	b.refill(time.Now())
+	if b.rate <= 0 {
+		b.lock.Unlock()
+		return true
+	}
+	if b.tokens >= 1 {
+		b.tokens--
+		b.lock.Unlock()
+		return true
+	}
+	if !block {
+		b.lock.Unlock()
+		return false
+	}
+	wait := time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		b.lock.Unlock()
+		return false
+	}
+	// reserve the token, and give it back if ctx is done
+	b.tokens--
+	b.lock.Unlock()
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		b.lock.Lock()
+		b.tokens++
+		b.lock.Unlock()
+		return false
+	}
+}
+
+// giveBack returns a taken token to the bucket, when the call is rejected by following limits
+func (b *tokenBucket) giveBack() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.rate > 0 {
+		b.tokens = math.Min(float64(b.burst), b.tokens+1)
+	}
+}
+
+func (b *tokenBucket) set(rate float64, burst int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.refill(time.Now())
+	if b.rate <= 0 {
+		// bucket without rate limit is full
+		b.tokens = float64(burst)
+	}
+	b.rate = rate
+	b.burst = burst
+	b.tokens = math.Min(b.tokens, float64(burst))
+}
+
+func (b *tokenBucket) get() (float64, int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.rate, b.burst
+}
+
+// bulkhead permits at most max concurrent calls, max can be changed at runtime
+type bulkhead struct {
+	lock       sync.Mutex
+	max        int
+	concurrent int
+	// released is closed and replaced when permit is released or max is changed, to wake up waiting calls
+	released chan struct{}
+}
+
+func newBulkhead(max int) *bulkhead {
+	return &bulkhead{
+		max:      max,
+		released: make(chan struct{}),
+	}
+}
+
+// acquire returns true if a permit is acquired, if block is true, it waits for the permit until ctx is done
+func (b *bulkhead) acquire(ctx context.Context, block bool) bool {
+	for {
+		b.lock.Lock()
+		if b.max <= 0 || b.concurrent < b.max {
+			b.concurrent++
+			b.lock.Unlock()
+			return true
+		}
+		released := b.released
+		b.lock.Unlock()
+		if !block {
+			return false
+		}
+		select {
+		case <-released:
+		case <-ctx.Done():
+			return false
+		}
+	}
+}
+
+func (b *bulkhead) release() {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.concurrent--
+	b.notify()
+}
+
+func (b *bulkhead) set(max int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	b.max = max
+	b.notify()
+}
+
+func (b *bulkhead) get() (int, int) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.max, b.concurrent
+}
+
+func (b *bulkhead) notify() {
+	close(b.released)
+	b.released = make(chan struct{})
+}
+
+// methodLimiter applies rate limit and then bulkhead to calls of one method
+type methodLimiter struct {
+	sdid     string
+	method   string
+	block    bool
+	maxWait  time.Duration
+	bucket   *tokenBucket
+	bulkhead *bulkhead
+	passed   int64
+	rejected int64
+}
+
+func newMethodLimiter(sdid, method string, rule *Rule) *methodLimiter {
+	burst := rule.Burst
+	if burst == 0 {
+		burst = getDefaultBurst(rule.Rate)
+	}
+	return &methodLimiter{
+		sdid:     sdid,
+		method:   method,
+		block:    rule.Block,
+		maxWait:  rule.MaxWait,
+		bucket:   newTokenBucket(rule.Rate, burst),
+		bulkhead: newBulkhead(rule.MaxConcurrent),
+	}
+}
+
+// acquire returns release function of permit, or *RejectedError if the call is rejected
+func (l *methodLimiter) acquire(ctx context.Context) (func(), error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if l.block && l.maxWait > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, l.maxWait)
+		defer cancel()
+	}
+	if !l.bucket.take(ctx, l.block) {
+		return nil, l.reject(ReasonRateLimit)
+	}
+	if !l.bulkhead.acquire(ctx, l.block) {
+		l.bucket.giveBack()
+		return nil, l.reject(ReasonBulkhead)
+	}
+	atomic.AddInt64(&l.passed, 1)
+	return l.bulkhead.release, nil
+}
+
+func (l *methodLimiter) reject(reason string) error {
+	atomic.AddInt64(&l.rejected, 1)
+	return &RejectedError{
+		SDID:   l.sdid,
+		Method: l.method,
+		Reason: reason,
+	}
+}
+
+// set changes limits of fields that are not nil
+func (l *methodLimiter) set(rate *float64, burst *int, maxConcurrent *int) {
+	if rate != nil || burst != nil {
+		newRate, newBurst := l.bucket.get()
+		if rate != nil {
+			newRate = *rate
+			if burst == nil {
+				newBurst = getDefaultBurst(newRate)
+			}
+		}
+		if burst != nil {
+			newBurst = *burst
+		}
+		l.bucket.set(newRate, newBurst)
+	}
+	if maxConcurrent != nil {
+		l.bulkhead.set(*maxConcurrent)
+	}
+}
+
+func (l *methodLimiter) describe() *limitPB.LimitStatus {
+	rate, burst := l.bucket.get()
+	maxConcurrent, concurrent := l.bulkhead.get()
+	return &limitPB.LimitStatus{
+		Sdid:          l.sdid,
+		Method:        l.method,
+		Rate:          rate,
+		Burst:         int64(burst),
+		MaxConcurrent: int64(maxConcurrent),
+		Concurrent:    int64(concurrent),
+		Passed:        atomic.LoadInt64(&l.passed),
+		Rejected:      atomic.LoadInt64(&l.rejected),
+		Block:         l.block,
+	}
+}
@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenBucket(t *testing.T) {
+	t.Run("reject after burst", func(t *testing.T) {
+		b := newTokenBucket(10, 2)
+		assert.True(t, b.take(context.Background(), false))
+		assert.True(t, b.take(context.Background(), false))
+		assert.False(t, b.take(context.Background(), false))
+		time.Sleep(time.Millisecond * 120)
+		assert.True(t, b.take(context.Background(), false))
+	})
+
+	t.Run("block until token is refilled", func(t *testing.T) {
+		b := newTokenBucket(20, 1)
+		assert.True(t, b.take(context.Background(), true))
+		start := time.Now()
+		assert.True(t, b.take(context.Background(), true))
+		assert.True(t, time.Since(start) >= time.Millisecond*40)
+	})
+
+	t.Run("not block beyond deadline", func(t *testing.T) {
+		b := newTokenBucket(1, 1)
+		assert.True(t, b.take(context.Background(), true))
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancel()
+		assert.False(t, b.take(ctx, true))
+	})
+
+	t.Run("set rate", func(t *testing.T) {
+		b := newTokenBucket(0, 1)
+		for i := 0; i < 10; i++ {
+			assert.True(t, b.take(context.Background(), false))
+		}
+		b.set(1, 1)
+		assert.True(t, b.take(context.Background(), false))
+		assert.False(t, b.take(context.Background(), false))
+		b.set(0, 1)
+		assert.True(t, b.take(context.Background(), false))
+	})
+}
+
+func TestBulkhead(t *testing.T) {
+	t.Run("reject when full", func(t *testing.T) {
+		b := newBulkhead(1)
+		assert.True(t, b.acquire(context.Background(), false))
+		assert.False(t, b.acquire(context.Background(), false))
+		b.release()
+		assert.True(t, b.acquire(context.Background(), false))
+	})
+
+	t.Run("block until released", func(t *testing.T) {
+		b := newBulkhead(1)
+		assert.True(t, b.acquire(context.Background(), true))
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.True(t, b.acquire(context.Background(), true))
+		}()
+		time.Sleep(time.Millisecond * 20)
+		_, concurrent := b.get()
+		assert.Equal(t, 1, concurrent)
+		b.release()
+		wg.Wait()
+	})
+
+	t.Run("block until max is raised", func(t *testing.T) {
+		b := newBulkhead(1)
+		assert.True(t, b.acquire(context.Background(), true))
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.True(t, b.acquire(context.Background(), true))
+		}()
+		time.Sleep(time.Millisecond * 20)
+		b.set(2)
+		wg.Wait()
+		_, concurrent := b.get()
+		assert.Equal(t, 2, concurrent)
+	})
+
+	t.Run("stop blocking when context is done", func(t *testing.T) {
+		b := newBulkhead(1)
+		assert.True(t, b.acquire(context.Background(), true))
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*20)
+		defer cancel()
+		assert.False(t, b.acquire(ctx, true))
+	})
+}
+
+func TestMethodLimiter(t *testing.T) {
+	l := newMethodLimiter("sdid", "Method", &Rule{
+		MaxConcurrent: 1,
+		Block:         true,
+		MaxWait:       time.Millisecond * 20,
+	})
+	release, err := l.acquire(nil)
+	assert.Nil(t, err)
+	_, err = l.acquire(nil)
+	assert.Equal(t, &RejectedError{SDID: "sdid", Method: "Method", Reason: ReasonBulkhead}, err)
+	release()
+
+	rate := float64(1)
+	l.set(&rate, nil, nil)
+	status := l.describe()
+	assert.Equal(t, float64(1), status.Rate)
+	assert.Equal(t, int64(1), status.Burst)
+	assert.Equal(t, int64(1), status.MaxConcurrent)
+	assert.Equal(t, int64(1), status.Passed)
+	assert.Equal(t, int64(1), status.Rejected)
+}
+
+func TestMethodLimiter_GiveBackTokenRejectedByBulkhead(t *testing.T) {
+	l := newMethodLimiter("sdid", "Method", &Rule{
+		Rate:          0.001,
+		Burst:         2,
+		MaxConcurrent: 1,
+	})
+	release, err := l.acquire(nil)
+	assert.Nil(t, err)
+	_, err = l.acquire(nil)
+	assert.Equal(t, &RejectedError{SDID: "sdid", Method: "Method", Reason: ReasonBulkhead}, err)
+	release()
+
+	// the token taken by the call rejected by bulkhead is given back
+	release, err = l.acquire(nil)
+	assert.Nil(t, err)
+	release()
+	_, err = l.acquire(nil)
+	assert.Equal(t, &RejectedError{SDID: "sdid", Method: "Method", Reason: ReasonRateLimit}, err)
+}
@@ -0,0 +1,92 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limit
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+var (
+	// limiters are limiters of methods, nil value means the method is not limited
+	limiters     = make(map[string]*methodLimiter)
+	limitersLock sync.Mutex
+)
+
+func resetLimiters() {
+	limitersLock.Lock()
+	defer limitersLock.Unlock()
+	limiters = make(map[string]*methodLimiter)
+}
+
+// getLimiter returns limiter of method set by marker and config, or nil if the method is not limited
+func getLimiter(sdid, methodName string) *methodLimiter {
+	key := common.GetMethodUniqueKey(sdid, methodName)
+	limitersLock.Lock()
+	defer limitersLock.Unlock()
+	if limiter, ok := limiters[key]; ok {
+		return limiter
+	}
+	var limiter *methodLimiter
+	rule := &Rule{}
+	marked := parseRuleFromSDMetadata(autowire.GetStructDescriptor(sdid), methodName, rule)
+	configRule, configured := limitConfig[sdid][methodName]
+	if marked || configured {
+		if err := aop.DecodeMethodRule(configRule, rule); err != nil {
+			logger.Red("[AOP limit] Invalid limit config of %s.%s(), error = %s", sdid, methodName, err)
+		}
+		limiter = newMethodLimiter(sdid, methodName, rule)
+	}
+	limiters[key] = limiter
+	return limiter
+}
+
+// getSortedLimiters returns all created limiters sorted by sdid and method
+func getSortedLimiters() []*methodLimiter {
+	limitersLock.Lock()
+	sortedLimiters := make([]*methodLimiter, 0, len(limiters))
+	for _, l := range limiters {
+		if l != nil {
+			sortedLimiters = append(sortedLimiters, l)
+		}
+	}
+	limitersLock.Unlock()
+	sort.Slice(sortedLimiters, func(i, j int) bool {
+		if sortedLimiters[i].sdid != sortedLimiters[j].sdid {
+			return sortedLimiters[i].sdid < sortedLimiters[j].sdid
+		}
+		return sortedLimiters[i].method < sortedLimiters[j].method
+	})
+	return sortedLimiters
+}
+
+// parseRuleFromSDMetadata parses rule generated from marker '+ioc:aop:limit' into rule, which is like
+// "limit": map[string]map[string]interface{}{"GetUser": {"rate": 100}}, it returns false if method is not marked
+func parseRuleFromSDMetadata(sd *autowire.StructDescriptor, methodName string, rule *Rule) bool {
+	if sd == nil {
+		return false
+	}
+	ok, err := aop.ParseMethodRuleFromSDMetadata(sd.Metadata, Name, methodName, rule)
+	if err != nil {
+		logger.Red("[AOP limit] Invalid limit marker of %s.%s(), error = %s", sd.ID(), methodName, err)
+	}
+	return ok
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limit
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	limitPB "github.com/alibaba/ioc-golang/extension/aop/limit/api/ioc_golang/aop/limit"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:paramType=limitServiceParam
+// +ioc:autowire:constructFunc=Init
+// +ioc:autowire:proxy=false
+
+type limitService struct {
+	limitPB.UnimplementedLimitServiceServer
+	appName string
+}
+
+type limitServiceParam struct {
+	AppName string
+}
+
+func (p *limitServiceParam) Init(s *limitService) (*limitService, error) {
+	s.appName = p.AppName
+	return s, nil
+}
+
+func (s *limitService) List(_ context.Context, _ *emptypb.Empty) (*limitPB.ListLimitsResponse, error) {
+	sortedLimiters := getSortedLimiters()
+	statuses := make([]*limitPB.LimitStatus, 0, len(sortedLimiters))
+	for _, l := range sortedLimiters {
+		statuses = append(statuses, l.describe())
+	}
+	return &limitPB.ListLimitsResponse{
+		Limits:  statuses,
+		AppName: s.appName,
+	}, nil
+}
+
+func (s *limitService) Set(_ context.Context, req *limitPB.SetLimitRequest) (*limitPB.LimitStatus, error) {
+	limiter := getLimiter(req.GetSdid(), req.GetMethod())
+	if limiter == nil {
+		return nil, fmt.Errorf("limit of %s.%s() is not found, which should be declared by marker or config",
+			req.GetSdid(), req.GetMethod())
+	}
+	var rate *float64
+	var burst, maxConcurrent *int
+	if req.Rate != nil {
+		if req.Rate.GetValue() < 0 {
+			return nil, fmt.Errorf("invalid rate %f", req.Rate.GetValue())
+		}
+		rate = &req.Rate.Value
+	}
+	if req.Burst != nil {
+		if req.Burst.GetValue() < 1 {
+			return nil, fmt.Errorf("invalid burst %d", req.Burst.GetValue())
+		}
+		b := int(req.Burst.GetValue())
+		burst = &b
+	}
+	if req.MaxConcurrent != nil {
+		if req.MaxConcurrent.GetValue() < 0 {
+			return nil, fmt.Errorf("invalid max concurrent %d", req.MaxConcurrent.GetValue())
+		}
+		m := int(req.MaxConcurrent.GetValue())
+		maxConcurrent = &m
+	}
+	limiter.set(rate, burst, maxConcurrent)
+	status := limiter.describe()
+	logger.Red("[Debug Server] Limit of %s.%s() is set to rate %.2f, burst %d, max concurrent %d",
+		req.GetSdid(), req.GetMethod(), status.GetRate(), status.GetBurst(), status.GetMaxConcurrent())
+	return status, nil
+}
@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package limit
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/alibaba/ioc-golang/aop/common"
+	limitPB "github.com/alibaba/ioc-golang/extension/aop/limit/api/ioc_golang/aop/limit"
+)
+
+func TestLimitService(t *testing.T) {
+	common.SetConfigForTest(t, &limitConfig, LimitConfig{
+		testSDID: {
+			"Call": {
+				"rate": 10,
+			},
+		},
+	}, resetLimiters)
+	service, err := GetlimitServiceSingleton(&limitServiceParam{
+		AppName: "test-app",
+	})
+	assert.Nil(t, err)
+
+	// limiters are created when proxy is created, or when it's set
+	status, err := service.Set(context.TODO(), &limitPB.SetLimitRequest{
+		Sdid:          testSDID,
+		Method:        "Call",
+		MaxConcurrent: wrapperspb.Int64(3),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, float64(10), status.GetRate())
+	assert.Equal(t, int64(10), status.GetBurst())
+	assert.Equal(t, int64(3), status.GetMaxConcurrent())
+
+	status, err = service.Set(context.TODO(), &limitPB.SetLimitRequest{
+		Sdid:   testSDID,
+		Method: "Call",
+		Rate:   wrapperspb.Double(5),
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, float64(5), status.GetRate())
+	assert.Equal(t, int64(5), status.GetBurst())
+	assert.Equal(t, int64(3), status.GetMaxConcurrent())
+
+	rsp, err := service.List(context.TODO(), &emptypb.Empty{})
+	assert.Nil(t, err)
+	assert.Equal(t, "test-app", rsp.GetAppName())
+	assert.Equal(t, 1, len(rsp.GetLimits()))
+	assert.Equal(t, "Call", rsp.GetLimits()[0].GetMethod())
+
+	_, err = service.Set(context.TODO(), &limitPB.SetLimitRequest{
+		Sdid:   testSDID,
+		Method: "NotLimited",
+		Rate:   wrapperspb.Double(5),
+	})
+	assert.NotNil(t, err)
+
+	_, err = service.Set(context.TODO(), &limitPB.SetLimitRequest{
+		Sdid:   testSDID,
+		Method: "Call",
+		Burst:  wrapperspb.Int64(0),
+	})
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,78 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by iocli, run 'iocli gen' to re-generate
+
+package limit
+
+import (
+	autowire "github.com/alibaba/ioc-golang/autowire"
+	singleton "github.com/alibaba/ioc-golang/autowire/singleton"
+	util "github.com/alibaba/ioc-golang/autowire/util"
+)
+
+func init() {
+	interceptorImplStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &interceptorImpl{}
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	singleton.RegisterStructDescriptor(interceptorImplStructDescriptor)
+	limitServiceStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &limitService{}
+		},
+		ParamFactory: func() interface{} {
+			var _ limitServiceParamInterface = &limitServiceParam{}
+			return &limitServiceParam{}
+		},
+		ConstructFunc: func(i interface{}, p interface{}) (interface{}, error) {
+			param := p.(limitServiceParamInterface)
+			impl := i.(*limitService)
+			return param.Init(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	singleton.RegisterStructDescriptor(limitServiceStructDescriptor)
+}
+
+type limitServiceParamInterface interface {
+	Init(impl *limitService) (*limitService, error)
+}
+
+var _interceptorImplSDID string
+
+func GetinterceptorImplSingleton() (*interceptorImpl, error) {
+	if _interceptorImplSDID == "" {
+		_interceptorImplSDID = util.GetSDIDByStructPtr(new(interceptorImpl))
+	}
+	i, err := singleton.GetImpl(_interceptorImplSDID, nil)
+	if err != nil {
+		return nil, err
+	}
+	impl := i.(*interceptorImpl)
+	return impl, nil
+}
+
+var _limitServiceSDID string
+
+func GetlimitServiceSingleton(p *limitServiceParam) (*limitService, error) {
+	if _limitServiceSDID == "" {
+		_limitServiceSDID = util.GetSDIDByStructPtr(new(limitService))
+	}
+	i, err := singleton.GetImpl(_limitServiceSDID, p)
+	if err != nil {
+		return nil, err
+	}
+	impl := i.(*limitService)
+	return impl, nil
+}
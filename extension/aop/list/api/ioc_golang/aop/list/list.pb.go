@@ -84,9 +84,10 @@ type ServiceMetadata struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InterfaceName      string   `protobuf:"bytes,1,opt,name=interfaceName,proto3" json:"interfaceName,omitempty"`
-	ImplementationName string   `protobuf:"bytes,2,opt,name=implementationName,proto3" json:"implementationName,omitempty"`
-	Methods            []string `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	InterfaceName      string            `protobuf:"bytes,1,opt,name=interfaceName,proto3" json:"interfaceName,omitempty"`
+	ImplementationName string            `protobuf:"bytes,2,opt,name=implementationName,proto3" json:"implementationName,omitempty"`
+	Methods            []string          `protobuf:"bytes,3,rep,name=methods,proto3" json:"methods,omitempty"`
+	MethodMetadata     []*MethodMetadata `protobuf:"bytes,4,rep,name=methodMetadata,proto3" json:"methodMetadata,omitempty"`
 }
 
 func (x *ServiceMetadata) Reset() {
@@ -142,6 +143,69 @@ func (x *ServiceMetadata) GetMethods() []string {
 	return nil
 }
 
+func (x *ServiceMetadata) GetMethodMetadata() []*MethodMetadata {
+	if x != nil {
+		return x.MethodMetadata
+	}
+	return nil
+}
+
+type MethodMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// interceptors are names of AOPs applied to the method, in the order of chain
+	Interceptors []string `protobuf:"bytes,2,rep,name=interceptors,proto3" json:"interceptors,omitempty"`
+}
+
+func (x *MethodMetadata) Reset() {
+	*x = MethodMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_list_api_ioc_golang_aop_list_list_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MethodMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodMetadata) ProtoMessage() {}
+
+func (x *MethodMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_list_api_ioc_golang_aop_list_list_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodMetadata.ProtoReflect.Descriptor instead.
+func (*MethodMetadata) Descriptor() ([]byte, []int) {
+	return file_extension_aop_list_api_ioc_golang_aop_list_list_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MethodMetadata) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MethodMetadata) GetInterceptors() []string {
+	if x != nil {
+		return x.Interceptors
+	}
+	return nil
+}
+
 var File_extension_aop_list_api_ioc_golang_aop_list_list_proto protoreflect.FileDescriptor
 
 var file_extension_aop_list_api_ioc_golang_aop_list_list_proto_rawDesc = []byte{
@@ -159,7 +223,7 @@ var file_extension_aop_list_api_ioc_golang_aop_list_list_proto_rawDesc = []byte{
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52,
 	0x0f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
 	0x12, 0x18, 0x0a, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0f, 0x53,
+	0x09, 0x52, 0x07, 0x61, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xce, 0x01, 0x0a, 0x0f, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x24,
 	0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
@@ -167,15 +231,24 @@ var file_extension_aop_list_api_ioc_golang_aop_list_list_proto_rawDesc = []byte{
 	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x12, 0x69, 0x6d, 0x70, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x32, 0x59,
-	0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a,
-	0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e,
-	0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6c,
-	0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x15, 0x5a, 0x13, 0x69, 0x6f, 0x63,
-	0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61, 0x6f, 0x70, 0x2f, 0x6c, 0x69, 0x73, 0x74,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x4b,
+	0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c,
+	0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x4d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x48, 0x0a, 0x0e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65,
+	0x70, 0x74, 0x6f, 0x72, 0x73, 0x32, 0x59, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x4a, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x28, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
+	0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6c, 0x69, 0x73, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x42, 0x15, 0x5a, 0x13, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61,
+	0x6f, 0x70, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -190,21 +263,23 @@ func file_extension_aop_list_api_ioc_golang_aop_list_list_proto_rawDescGZIP() []
 	return file_extension_aop_list_api_ioc_golang_aop_list_list_proto_rawDescData
 }
 
-var file_extension_aop_list_api_ioc_golang_aop_list_list_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_extension_aop_list_api_ioc_golang_aop_list_list_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_extension_aop_list_api_ioc_golang_aop_list_list_proto_goTypes = []interface{}{
 	(*ListServiceResponse)(nil), // 0: ioc_golang.aop.list.ListServiceResponse
 	(*ServiceMetadata)(nil),     // 1: ioc_golang.aop.list.ServiceMetadata
-	(*emptypb.Empty)(nil),       // 2: google.protobuf.Empty
+	(*MethodMetadata)(nil),      // 2: ioc_golang.aop.list.MethodMetadata
+	(*emptypb.Empty)(nil),       // 3: google.protobuf.Empty
 }
 var file_extension_aop_list_api_ioc_golang_aop_list_list_proto_depIdxs = []int32{
 	1, // 0: ioc_golang.aop.list.ListServiceResponse.serviceMetadata:type_name -> ioc_golang.aop.list.ServiceMetadata
-	2, // 1: ioc_golang.aop.list.ListService.List:input_type -> google.protobuf.Empty
-	0, // 2: ioc_golang.aop.list.ListService.List:output_type -> ioc_golang.aop.list.ListServiceResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: ioc_golang.aop.list.ServiceMetadata.methodMetadata:type_name -> ioc_golang.aop.list.MethodMetadata
+	3, // 2: ioc_golang.aop.list.ListService.List:input_type -> google.protobuf.Empty
+	0, // 3: ioc_golang.aop.list.ListService.List:output_type -> ioc_golang.aop.list.ListServiceResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_extension_aop_list_api_ioc_golang_aop_list_list_proto_init() }
@@ -237,6 +312,18 @@ func file_extension_aop_list_api_ioc_golang_aop_list_list_proto_init() {
 				return nil
 			}
 		}
+		file_extension_aop_list_api_ioc_golang_aop_list_list_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MethodMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -244,7 +331,7 @@ func file_extension_aop_list_api_ioc_golang_aop_list_list_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_extension_aop_list_api_ioc_golang_aop_list_list_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -18,6 +18,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
@@ -51,7 +52,19 @@ var list = &cobra.Command{
 		}
 		for _, v := range rsp.ServiceMetadata {
 			logger.Blue(v.ImplementationName)
-			logger.Blue("%s", v.Methods)
+			if len(v.MethodMetadata) == 0 {
+				// server of old version doesn't return method metadata
+				logger.Blue("%s", v.Methods)
+				logger.Blue("")
+				continue
+			}
+			for _, m := range v.MethodMetadata {
+				if len(m.Interceptors) == 0 {
+					logger.Blue("  %s", m.Name)
+					continue
+				}
+				logger.Blue("  %s: %s", m.Name, strings.Join(m.Interceptors, " -> "))
+			}
 			logger.Blue("")
 		}
 	},
@@ -56,8 +56,16 @@ func (l *listServiceImpl) List(_ context.Context, _ *emptypb.Empty) (*list.ListS
 			methods = append(methods, key)
 		}
 		sort.Sort(methods)
+		methodMetadatas := make([]*list.MethodMetadata, 0, len(methods))
+		for _, method := range methods {
+			methodMetadatas = append(methodMetadatas, &list.MethodMetadata{
+				Name:         method,
+				Interceptors: v.MethodMetadata[method].Interceptors,
+			})
+		}
 		structsMetadatas = append(structsMetadatas, &list.ServiceMetadata{
 			Methods:            methods,
+			MethodMetadata:     methodMetadatas,
 			InterfaceName:      key,
 			ImplementationName: key,
 		})
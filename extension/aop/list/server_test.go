@@ -33,7 +33,9 @@ func TestListServiceImpl(t *testing.T) {
 	debugMetadata["github.com/alibaba/ioc-golang/aop/test.Struct2"] = &common.StructMetadata{
 		MethodMetadata: map[string]*common.MethodMetadata{},
 	}
-	debugMetadata["github.com/alibaba/ioc-golang/aop/test.Struct1"].MethodMetadata["MockMethod1"] = &common.MethodMetadata{}
+	debugMetadata["github.com/alibaba/ioc-golang/aop/test.Struct1"].MethodMetadata["MockMethod1"] = &common.MethodMetadata{
+		Interceptors: []string{"monitor", "trace"},
+	}
 	debugMetadata["github.com/alibaba/ioc-golang/aop/test.Struct1"].MethodMetadata["MockMethod2"] = &common.MethodMetadata{}
 	debugMetadata["github.com/alibaba/ioc-golang/aop/test.Struct1"].MethodMetadata["MockMethod3"] = &common.MethodMetadata{}
 	debugMetadata["github.com/alibaba/ioc-golang/aop/test.Struct2"].MethodMetadata["MockMethod1"] = &common.MethodMetadata{}
@@ -52,6 +54,10 @@ func TestListServiceImpl(t *testing.T) {
 	assert.Equal(t, "MockMethod1", serviceMetadatas[0].Methods[0])
 	assert.Equal(t, "MockMethod2", serviceMetadatas[0].Methods[1])
 	assert.Equal(t, "MockMethod3", serviceMetadatas[0].Methods[2])
+	assert.Equal(t, 3, len(serviceMetadatas[0].MethodMetadata))
+	assert.Equal(t, "MockMethod1", serviceMetadatas[0].MethodMetadata[0].Name)
+	assert.Equal(t, []string{"monitor", "trace"}, serviceMetadatas[0].MethodMetadata[0].Interceptors)
+	assert.Equal(t, 0, len(serviceMetadatas[0].MethodMetadata[1].Interceptors))
 
 	assert.Equal(t, "github.com/alibaba/ioc-golang/aop/test.Struct2", serviceMetadatas[1].ImplementationName)
 	assert.Equal(t, 2, len(serviceMetadatas[1].Methods))
@@ -34,7 +34,8 @@ const Name = "log"
 
 func init() {
 	aop.RegisterAOP(aop.AOP{
-		Name: Name,
+		Name:  Name,
+		Order: aop.OrderLog,
 		GRPCServiceRegister: func(server *grpc.Server) {
 			logServiceImplSingleton, _ := GetlogServiceImplSingleton()
 			logPB.RegisterLogServiceServer(server, logServiceImplSingleton)
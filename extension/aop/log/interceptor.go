@@ -116,7 +116,7 @@ func (w *logInterceptor) BeforeInvoke(ctx *aop.InvocationContext) {
 
 	// [Feature2]
 	// 1. find if already in goroutine tracing
-	if w.GoRoutineInterceptor.GetCurrentGRTracingContext(logGoRoutineInterceptorFacadeCtxType) != nil {
+	if w.GoRoutineInterceptor.GetTracingContext(ctx, logGoRoutineInterceptorFacadeCtxType) != nil {
 		w.GoRoutineInterceptor.BeforeInvoke(ctx, logGoRoutineInterceptorFacadeCtxType)
 		return
 	}
@@ -162,7 +162,7 @@ func (w *logInterceptor) BeforeInvoke(ctx *aop.InvocationContext) {
 	})
 
 	// start tracing
-	w.GoRoutineInterceptor.AddCurrentGRTracingContext(grCtx)
+	w.GoRoutineInterceptor.AddTracingContext(ctx, grCtx)
 	w.GoRoutineInterceptor.BeforeInvoke(ctx, logGoRoutineInterceptorFacadeCtxType)
 }
 
@@ -177,6 +177,9 @@ func (w *logInterceptor) AfterInvoke(ctx *aop.InvocationContext) {
 	w.invocationAOPLogFFunction("\n[AOP Function Response] %s\n%s\n\n",
 		w.InvocationCtxLogsGenerator.GetFunctionSignatureLogs(ctx.SDID, ctx.MethodName, false),
 		w.InvocationCtxLogsGenerator.GetParamsLogs(common.ReflectValues2Strings(ctx.ReturnValues, w.printParamsMaxDepth, w.printParamsMaxLength), false))
+	if ctx.Panic != nil {
+		w.invocationCtxLogger.Errorf("\n[AOP Function Panic] %s.%s()\n%+v\n\n", ctx.SDID, ctx.MethodName, ctx.Panic)
+	}
 }
 
 func (w *logInterceptor) WatchLogs(logCtx *debugLogContext) {
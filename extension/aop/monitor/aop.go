@@ -16,17 +16,41 @@
 package monitor
 
 import (
+	"fmt"
+	"reflect"
+	"sync"
+	"sync/atomic"
+
 	"google.golang.org/grpc"
 
 	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/config"
 	monitorPB "github.com/alibaba/ioc-golang/extension/aop/monitor/api/ioc_golang/aop/monitor"
+	"github.com/alibaba/ioc-golang/logger"
 )
 
 const Name = "monitor"
 
+var (
+	// servingMetrics is *metrics served with servingMetricsConfig by the last ConfigLoader call, it is set if metrics
+	// is enabled by config, and records all invocations regardless of monitor sessions
+	servingMetrics        atomic.Value
+	servingMetricsConfig  MetricsConfig
+	servingMetricsAppName string
+	servingMetricsLock    sync.Mutex
+)
+
 func init() {
 	aop.RegisterAOP(aop.AOP{
-		Name: Name,
+		Name:  Name,
+		Order: aop.OrderMonitor,
+		ConfigLoader: func(aopConfig *common.Config) {
+			monitorConfig := &Config{}
+			_ = config.LoadConfigByPrefix(fmt.Sprintf("%s.%s", common.IOCGolangAOPConfigPrefix, Name), monitorConfig)
+			loadMetrics(aopConfig.AppName, monitorConfig.Metrics)
+		},
+		StopFunc: stopMetrics,
 		InterceptorFactory: func() aop.Interceptor {
 			monitorInterceptorImpl, _ := GetinterceptorImplSingleton()
 			return monitorInterceptorImpl
@@ -37,3 +61,54 @@ func init() {
 		},
 	})
 }
+
+/*
+loadMetrics serves metrics configured by metricsConfig. Metrics being served with the same config is kept, otherwise
+it is stopped before new metrics is served, so that ConfigLoader can be called by each ioc.Load in the same process.
+*/
+func loadMetrics(appName string, metricsConfig MetricsConfig) {
+	if metricsConfig.Port == "" {
+		metricsConfig.Port = defaultMetricsPort
+	}
+	if metricsConfig.Path == "" {
+		metricsConfig.Path = defaultMetricsPath
+	}
+	servingMetricsLock.Lock()
+	defer servingMetricsLock.Unlock()
+	if getServingMetrics() != nil && metricsConfig.Enable && servingMetricsAppName == appName &&
+		reflect.DeepEqual(servingMetricsConfig, metricsConfig) {
+		return
+	}
+	stopMetricsLocked()
+	if !metricsConfig.Enable {
+		return
+	}
+	m := newMetrics(appName, metricsConfig.Buckets)
+	if err := m.serve(metricsConfig.Port, metricsConfig.Path); err != nil {
+		logger.Red("[AOP monitor] Serve metrics at port %s failed, error = %s", metricsConfig.Port, err)
+		return
+	}
+	servingMetricsConfig, servingMetricsAppName = metricsConfig, appName
+	servingMetrics.Store(m)
+}
+
+// stopMetrics stops metrics served by loadMetrics, it is called by ioc.Stop()
+func stopMetrics() {
+	servingMetricsLock.Lock()
+	defer servingMetricsLock.Unlock()
+	stopMetricsLocked()
+}
+
+func stopMetricsLocked() {
+	m := getServingMetrics()
+	if m == nil {
+		return
+	}
+	servingMetrics.Store((*metrics)(nil))
+	m.stop()
+}
+
+func getServingMetrics() *metrics {
+	m, _ := servingMetrics.Load().(*metrics)
+	return m
+}
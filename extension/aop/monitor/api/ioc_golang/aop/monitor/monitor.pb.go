@@ -146,6 +146,17 @@ type MonitorResponseItem struct {
 	Fail      int64   `protobuf:"varint,6,opt,name=fail,proto3" json:"fail,omitempty"`
 	AvgRT     float32 `protobuf:"fixed32,7,opt,name=avgRT,proto3" json:"avgRT,omitempty"`
 	FailRate  float32 `protobuf:"fixed32,8,opt,name=failRate,proto3" json:"failRate,omitempty"`
+	// attempts is count of calling raw method, which is bigger than total if invocations are retried
+	Attempts int64 `protobuf:"varint,9,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	// p50RT, p90RT, p99RT and maxRT are latency percentiles in microseconds, with relative error less than 1/16
+	P50RT float32 `protobuf:"fixed32,10,opt,name=p50RT,proto3" json:"p50RT,omitempty"`
+	P90RT float32 `protobuf:"fixed32,11,opt,name=p90RT,proto3" json:"p90RT,omitempty"`
+	P99RT float32 `protobuf:"fixed32,12,opt,name=p99RT,proto3" json:"p99RT,omitempty"`
+	MaxRT float32 `protobuf:"fixed32,13,opt,name=maxRT,proto3" json:"maxRT,omitempty"`
+	// inFlight is count of invocations in progress when the item is collected
+	InFlight int64 `protobuf:"varint,14,opt,name=inFlight,proto3" json:"inFlight,omitempty"`
+	// errors are counts of failed invocations by error message, sorted by count in descending order
+	Errors []*ErrorCount `protobuf:"bytes,15,rep,name=errors,proto3" json:"errors,omitempty"`
 }
 
 func (x *MonitorResponseItem) Reset() {
@@ -236,6 +247,110 @@ func (x *MonitorResponseItem) GetFailRate() float32 {
 	return 0
 }
 
+func (x *MonitorResponseItem) GetAttempts() int64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *MonitorResponseItem) GetP50RT() float32 {
+	if x != nil {
+		return x.P50RT
+	}
+	return 0
+}
+
+func (x *MonitorResponseItem) GetP90RT() float32 {
+	if x != nil {
+		return x.P90RT
+	}
+	return 0
+}
+
+func (x *MonitorResponseItem) GetP99RT() float32 {
+	if x != nil {
+		return x.P99RT
+	}
+	return 0
+}
+
+func (x *MonitorResponseItem) GetMaxRT() float32 {
+	if x != nil {
+		return x.MaxRT
+	}
+	return 0
+}
+
+func (x *MonitorResponseItem) GetInFlight() int64 {
+	if x != nil {
+		return x.InFlight
+	}
+	return 0
+}
+
+func (x *MonitorResponseItem) GetErrors() []*ErrorCount {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ErrorCount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Count   int64  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *ErrorCount) Reset() {
+	*x = ErrorCount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorCount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorCount) ProtoMessage() {}
+
+func (x *ErrorCount) ProtoReflect() protoreflect.Message {
+	mi := &file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorCount.ProtoReflect.Descriptor instead.
+func (*ErrorCount) Descriptor() ([]byte, []int) {
+	return file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ErrorCount) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ErrorCount) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 var File_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto protoreflect.FileDescriptor
 
 var file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_rawDesc = []byte{
@@ -257,7 +372,7 @@ var file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_rawDesc
 	0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x6f, 0x6e,
 	0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d,
 	0x52, 0x14, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xd5, 0x01, 0x0a, 0x13, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
+	0x65, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xa1, 0x03, 0x0a, 0x13, 0x4d, 0x6f, 0x6e, 0x69, 0x74,
 	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x1c,
 	0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x12, 0x0a, 0x04,
@@ -270,17 +385,33 @@ var file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_rawDesc
 	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x66, 0x61, 0x69, 0x6c, 0x12, 0x14, 0x0a, 0x05,
 	0x61, 0x76, 0x67, 0x52, 0x54, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x61, 0x76, 0x67,
 	0x52, 0x54, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x32, 0x70,
-	0x0a, 0x0e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x5e, 0x0a, 0x07, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x2e, 0x69, 0x6f,
-	0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67,
-	0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x6f, 0x6e,
-	0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
-	0x42, 0x18, 0x5a, 0x16, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61,
-	0x6f, 0x70, 0x2f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x66, 0x61, 0x69, 0x6c, 0x52, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x35,
+	0x30, 0x52, 0x54, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x35, 0x30, 0x52, 0x54,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x39, 0x30, 0x52, 0x54, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x05, 0x70, 0x39, 0x30, 0x52, 0x54, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x39, 0x39, 0x52, 0x54, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x70, 0x39, 0x39, 0x52, 0x54, 0x12, 0x14, 0x0a, 0x05,
+	0x6d, 0x61, 0x78, 0x52, 0x54, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x6d, 0x61, 0x78,
+	0x52, 0x54, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x3a,
+	0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x3c, 0x0a, 0x0a, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x32, 0x70, 0x0a, 0x0e, 0x4d, 0x6f, 0x6e, 0x69,
+	0x74, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x5e, 0x0a, 0x07, 0x4d, 0x6f,
+	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x2e, 0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61,
+	0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x69, 0x6f, 0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x61, 0x6f, 0x70, 0x2e, 0x6d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x18, 0x5a, 0x16, 0x69, 0x6f,
+	0x63, 0x5f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x61, 0x6f, 0x70, 0x2f, 0x6d, 0x6f, 0x6e,
+	0x69, 0x74, 0x6f, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -295,21 +426,23 @@ func file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_rawDesc
 	return file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_rawDescData
 }
 
-var file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_goTypes = []interface{}{
 	(*MonitorRequest)(nil),      // 0: ioc_golang.aop.monitor.MonitorRequest
 	(*MonitorResponse)(nil),     // 1: ioc_golang.aop.monitor.MonitorResponse
 	(*MonitorResponseItem)(nil), // 2: ioc_golang.aop.monitor.MonitorResponseItem
+	(*ErrorCount)(nil),          // 3: ioc_golang.aop.monitor.ErrorCount
 }
 var file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_depIdxs = []int32{
 	2, // 0: ioc_golang.aop.monitor.MonitorResponse.monitorResponseItems:type_name -> ioc_golang.aop.monitor.MonitorResponseItem
-	0, // 1: ioc_golang.aop.monitor.MonitorService.Monitor:input_type -> ioc_golang.aop.monitor.MonitorRequest
-	1, // 2: ioc_golang.aop.monitor.MonitorService.Monitor:output_type -> ioc_golang.aop.monitor.MonitorResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: ioc_golang.aop.monitor.MonitorResponseItem.errors:type_name -> ioc_golang.aop.monitor.ErrorCount
+	0, // 2: ioc_golang.aop.monitor.MonitorService.Monitor:input_type -> ioc_golang.aop.monitor.MonitorRequest
+	1, // 3: ioc_golang.aop.monitor.MonitorService.Monitor:output_type -> ioc_golang.aop.monitor.MonitorResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_init() }
@@ -354,6 +487,18 @@ func file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_init()
 				return nil
 			}
 		}
+		file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorCount); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -361,7 +506,7 @@ func file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_init()
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_extension_aop_monitor_api_ioc_golang_aop_monitor_monitor_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -105,8 +105,11 @@ var monitorCommand = &cobra.Command{
 				total := int64(0)
 				success := int64(0)
 				fail := int64(0)
+				attempts := int64(0)
 				avgRT := float32(0)
 				avgFailRate := float32(0)
+				maxRT := float32(0)
+				errorCountsMap := make(map[string]int64)
 
 				allAvgRTS := make([]float32, 0)
 				allFailRates := make([]float32, 0)
@@ -119,13 +122,31 @@ var monitorCommand = &cobra.Command{
 					total += item.Total
 					fail += item.Fail
 					success += item.Success
+					attempts += item.Attempts
+					if item.MaxRT > maxRT {
+						maxRT = item.MaxRT
+					}
+					for _, errorCount := range item.Errors {
+						errorCountsMap[errorCount.Message] += errorCount.Count
+					}
 				}
 				avgRT = getAverageFloat32(allAvgRTS)
 				avgFailRate = getAverageFloat32(allFailRates)
 
 				// print information
-				logger.Blue(fmt.Sprintf("Total: %d, Success: %d, Fail: %d, AvgRT: %.2fus, FailRate: %.2f%%",
-					total, success, fail, avgRT, avgFailRate*100))
+				logger.Blue(fmt.Sprintf("Total: %d, Attempts: %d, Success: %d, Fail: %d, AvgRT: %.2fus, FailRate: %.2f%%, MaxRT: %.0fus",
+					total, attempts, success, fail, avgRT, avgFailRate*100, maxRT))
+				errorCounts := make([]*monitorPB.ErrorCount, 0, len(errorCountsMap))
+				for message, count := range errorCountsMap {
+					errorCounts = append(errorCounts, &monitorPB.ErrorCount{
+						Message: message,
+						Count:   count,
+					})
+				}
+				sort.Slice(errorCounts, func(i, j int) bool {
+					return errorCounts[i].Count > errorCounts[j].Count
+				})
+				printErrorCounts(errorCounts)
 			}
 
 			allMonitorResponseItemsLock.RUnlock()
@@ -143,8 +164,12 @@ var monitorCommand = &cobra.Command{
 			for _, item := range msg.MonitorResponseItems {
 				methodKey := fmt.Sprintf("%s.%s()", item.GetSdid(), item.GetMethod())
 				logger.Blue(methodKey)
-				logger.Blue(fmt.Sprintf("Total: %d, Success: %d, Fail: %d, AvgRT: %.2fus, FailRate: %.2f%%",
-					item.GetTotal(), item.GetSuccess(), item.GetFail(), item.GetAvgRT(), item.GetFailRate()*100))
+				logger.Blue(fmt.Sprintf("Total: %d, Attempts: %d, Success: %d, Fail: %d, AvgRT: %.2fus, FailRate: %.2f%%, InFlight: %d",
+					item.GetTotal(), item.GetAttempts(), item.GetSuccess(), item.GetFail(), item.GetAvgRT(), item.GetFailRate()*100,
+					item.GetInFlight()))
+				logger.Blue(fmt.Sprintf("P50RT: %.0fus, P90RT: %.0fus, P99RT: %.0fus, MaxRT: %.0fus",
+					item.GetP50RT(), item.GetP90RT(), item.GetP99RT(), item.GetMaxRT()))
+				printErrorCounts(item.GetErrors())
 
 				allMonitorResponseItemsLock.Lock()
 				if v, ok := allMonitorResponseItemsMap[methodKey]; ok {
@@ -165,6 +190,13 @@ func init() {
 	monitorCommand.Flags().IntVarP(&interval, "interval", "i", 5, "monitor interval")
 }
 
+// printErrorCounts prints counts of failed invocations by error message
+func printErrorCounts(errorCounts []*monitorPB.ErrorCount) {
+	for _, errorCount := range errorCounts {
+		logger.Red(fmt.Sprintf("  %d x %s", errorCount.GetCount(), errorCount.GetMessage()))
+	}
+}
+
 func getAverageFloat32(input []float32) float32 {
 	length := len(input)
 	if length == 0 {
@@ -0,0 +1,48 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package monitor
+
+const (
+	defaultMetricsPort = "2112"
+	defaultMetricsPath = "/metrics"
+)
+
+/*
+Config is config under 'ioc-golang.aop.monitor', like:
+
+	ioc-golang:
+	  aop:
+	    monitor:
+	      metrics:
+	        enable: true
+	        port: 2112
+	        path: /metrics
+	        buckets: [0.001, 0.01, 0.1, 1]
+*/
+type Config struct {
+	Metrics MetricsConfig `yaml:"metrics"`
+}
+
+// MetricsConfig configures metrics served in prometheus text format, which are recorded for all proxied methods
+type MetricsConfig struct {
+	Enable bool `yaml:"enable"`
+	// Port is local http port of metrics endpoint, default 2112
+	Port string `yaml:"port"`
+	// Path is http path of metrics endpoint, default /metrics
+	Path string `yaml:"path"`
+	// Buckets are upper bounds of latency histogram in seconds, default prometheus.DefBuckets
+	Buckets []float64 `yaml:"buckets"`
+}
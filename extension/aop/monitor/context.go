@@ -3,6 +3,7 @@ package monitor
 import (
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/alibaba/ioc-golang/aop"
@@ -24,7 +25,7 @@ type context struct {
 	stopCh                                  chan struct{}
 	methodUniqueNameInvocationRecordMap     map[string]methodInvocationRecordIOCInterface // methodUniqueName -> methodInvocationRecord
 	methodUniqueNameInvocationRecordMapLock sync.Mutex
-	destroyed                               bool
+	destroyOnce                             sync.Once
 }
 
 type contextParam struct {
@@ -41,7 +42,6 @@ func (p *contextParam) init(c *context) (*context, error) {
 
 	c.methodUniqueNameInvocationRecordMap = make(map[string]methodInvocationRecordIOCInterface)
 	c.stopCh = make(chan struct{})
-	c.destroyed = false
 	c.ticker = time.NewTicker(p.Period)
 	go c.run()
 	return c, nil
@@ -58,24 +58,22 @@ func (c *context) run() {
 			c.methodUniqueNameInvocationRecordMapLock.Lock()
 			for invocationMethodKey, invocationMethodRecord := range c.methodUniqueNameInvocationRecordMap {
 				sdid, methodName := common.ParseSDIDAndMethodFromUniqueKey(invocationMethodKey)
-				total, success, fail, agRT, failedRate := invocationMethodRecord.DescribeAndReset()
-				if total == 0 {
+				item := invocationMethodRecord.DescribeAndReset()
+				if item.Total == 0 && item.InFlight == 0 {
 					continue
 				}
-				monitorResponseItemSorter = append(monitorResponseItemSorter, &monitorPB.MonitorResponseItem{
-					Sdid:     sdid,
-					Method:   methodName,
-					Total:    int64(total),
-					Success:  int64(success),
-					Fail:     int64(fail),
-					AvgRT:    agRT,
-					FailRate: failedRate,
-				})
+				item.Sdid = sdid
+				item.Method = methodName
+				monitorResponseItemSorter = append(monitorResponseItemSorter, item)
 			}
 			c.methodUniqueNameInvocationRecordMapLock.Unlock()
 			sort.Sort(monitorResponseItemSorter)
-			c.ch <- &monitorPB.MonitorResponse{
+			select {
+			case c.ch <- &monitorPB.MonitorResponse{
 				MonitorResponseItems: monitorResponseItemSorter,
+			}:
+			case <-c.stopCh:
+				return
 			}
 		}
 	}
@@ -116,11 +114,10 @@ func (c *context) AfterInvoke(ctx *aop.InvocationContext) {
 }
 
 func (c *context) Destroy() {
-	if !c.destroyed {
-		c.destroyed = true
+	c.destroyOnce.Do(func() {
 		c.ticker.Stop()
 		close(c.stopCh)
-	}
+	})
 }
 
 // +ioc:autowire=true
@@ -129,63 +126,115 @@ func (c *context) Destroy() {
 // +ioc:autowire:proxy:autoInjection=false
 
 type methodInvocationRecord struct {
-	total   int
-	success int
-	fail    int
-	rts     []int64
-
-	grIDReqMap sync.Map // grID -> req before invoke time
+	total    int
+	success  int
+	fail     int
+	attempts int
+	rts      latencyHistogram
+	// errors is count of failed invocations by error message, at most maxErrorMessages messages are recorded
+	errors map[string]int64
+	// inFlight is count of invocations in progress, which is not reset
+	inFlight int64
+
+	startTimeMap sync.Map // invocation ID -> start time in microseconds
 
 	lock sync.RWMutex
 }
 
+const (
+	maxErrorMessages   = 20
+	otherErrorsMessage = "(other errors)"
+)
+
 func newMethodInvocationRecord(record *methodInvocationRecord) (*methodInvocationRecord, error) {
-	record.rts = make([]int64, 0)
+	record.errors = make(map[string]int64)
 	return record, nil
 }
 
-func (m *methodInvocationRecord) DescribeAndReset() (int, int, int, float32, float32) {
+// DescribeAndReset returns statistics of recorded invocations without sdid and method, and resets the record
+func (m *methodInvocationRecord) DescribeAndReset() *monitorPB.MonitorResponseItem {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	agRT := getAverageInt64(m.rts)
 	failedRate := float32(0)
 	if m.fail > 0 {
 		failedRate = float32(m.fail) / float32(m.total)
 	}
 
-	total := m.total
-	success := m.success
-	fail := m.fail
+	errorCounts := make([]*monitorPB.ErrorCount, 0, len(m.errors))
+	for message, count := range m.errors {
+		errorCounts = append(errorCounts, &monitorPB.ErrorCount{
+			Message: message,
+			Count:   count,
+		})
+	}
+	sort.Slice(errorCounts, func(i, j int) bool {
+		if errorCounts[i].Count != errorCounts[j].Count {
+			return errorCounts[i].Count > errorCounts[j].Count
+		}
+		return errorCounts[i].Message < errorCounts[j].Message
+	})
+
+	item := &monitorPB.MonitorResponseItem{
+		Total:    int64(m.total),
+		Success:  int64(m.success),
+		Fail:     int64(m.fail),
+		Attempts: int64(m.attempts),
+		AvgRT:    m.rts.average(),
+		FailRate: failedRate,
+		P50RT:    float32(m.rts.percentile(0.5)),
+		P90RT:    float32(m.rts.percentile(0.9)),
+		P99RT:    float32(m.rts.percentile(0.99)),
+		MaxRT:    float32(m.rts.max),
+		InFlight: atomic.LoadInt64(&m.inFlight),
+		Errors:   errorCounts,
+	}
 
 	m.total = 0
 	m.success = 0
 	m.fail = 0
-	m.rts = make([]int64, 0)
+	m.attempts = 0
+	m.rts.reset()
+	m.errors = make(map[string]int64)
 
-	return total, success, fail, agRT, failedRate
+	return item
 }
 
 func (m *methodInvocationRecord) BeforeRequest(ctx *aop.InvocationContext) {
-	m.grIDReqMap.Store(ctx.ID, time.Now().UnixMicro())
+	atomic.AddInt64(&m.inFlight, 1)
+	m.startTimeMap.Store(ctx.ID, time.Now().UnixMicro())
 }
 
 func (m *methodInvocationRecord) AfterRequest(ctx *aop.InvocationContext) {
-	val, ok := m.grIDReqMap.LoadAndDelete(ctx.ID)
+	val, ok := m.startTimeMap.LoadAndDelete(ctx.ID)
 	if !ok {
 		return
 	}
+	atomic.AddInt64(&m.inFlight, -1)
 	startTime := val.(int64)
 	duration := time.Now().UnixMicro() - startTime
 
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
-	m.rts = append(m.rts, duration)
+	m.rts.record(duration)
 	m.total += 1
-	if isFailed, _ := common.IsInvocationFailed(ctx.ReturnValues); isFailed {
-		m.fail += 1
-	} else {
+	m.attempts += ctx.Attempts
+	isFailed, err := common.IsInvocationFailed(ctx.ReturnValues)
+	if ctx.Panic != nil {
+		isFailed, err = true, ctx.Panic
+	}
+	if !isFailed {
 		m.success += 1
+		return
+	}
+	m.fail += 1
+	message := otherErrorsMessage
+	if err != nil {
+		message = err.Error()
+	}
+	if _, ok := m.errors[message]; !ok && len(m.errors) >= maxErrorMessages {
+		message = otherErrorsMessage
 	}
+	m.errors[message]++
 }
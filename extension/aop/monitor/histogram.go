@@ -0,0 +1,114 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package monitor
+
+import (
+	"math"
+	"math/bits"
+)
+
+// histogramSubBucketBits decides count of sub-buckets of each power of 2, 16 sub-buckets make relative error of
+// percentiles less than 1/16
+const histogramSubBucketBits = 4
+
+const histogramSubBuckets = 1 << histogramSubBucketBits
+
+/*
+latencyHistogram records latencies with log-linear buckets, values less than 2*histogramSubBuckets are recorded
+exactly, and bigger ones are recorded in one of histogramSubBuckets buckets between two powers of 2. It is not
+thread-safe.
+*/
+type latencyHistogram struct {
+	counts []int64
+	total  int64
+	sum    int64
+	max    int64
+}
+
+func getHistogramBucketIndex(value int64) int {
+	if value < histogramSubBuckets {
+		return int(value)
+	}
+	exponent := bits.Len64(uint64(value)) - 1
+	subBucket := int(value>>(exponent-histogramSubBucketBits)) & (histogramSubBuckets - 1)
+	return (exponent-histogramSubBucketBits+1)*histogramSubBuckets + subBucket
+}
+
+// getHistogramBucketUpperBound returns the biggest value recorded in bucket of index
+func getHistogramBucketUpperBound(index int) int64 {
+	if index < histogramSubBuckets {
+		return int64(index)
+	}
+	shift := index/histogramSubBuckets - 1
+	subBucket := int64(index % histogramSubBuckets)
+	return (histogramSubBuckets+subBucket+1)<<shift - 1
+}
+
+func (h *latencyHistogram) record(value int64) {
+	if value < 0 {
+		value = 0
+	}
+	index := getHistogramBucketIndex(value)
+	if index >= len(h.counts) {
+		counts := make([]int64, index+1)
+		copy(counts, h.counts)
+		h.counts = counts
+	}
+	h.counts[index]++
+	h.total++
+	h.sum += value
+	if value > h.max {
+		h.max = value
+	}
+}
+
+func (h *latencyHistogram) average() float32 {
+	if h.total == 0 {
+		return 0
+	}
+	return float32(h.sum) / float32(h.total)
+}
+
+// percentile returns upper bound of bucket containing the value at rank q in [0, 1], which is not bigger than max
+func (h *latencyHistogram) percentile(q float64) int64 {
+	if h.total == 0 {
+		return 0
+	}
+	rank := int64(math.Ceil(q * float64(h.total)))
+	if rank < 1 {
+		rank = 1
+	}
+	count := int64(0)
+	for index, c := range h.counts {
+		count += c
+		if count >= rank {
+			if upperBound := getHistogramBucketUpperBound(index); upperBound < h.max {
+				return upperBound
+			}
+			return h.max
+		}
+	}
+	return h.max
+}
+
+func (h *latencyHistogram) reset() {
+	for i := range h.counts {
+		h.counts[i] = 0
+	}
+	h.total = 0
+	h.sum = 0
+	h.max = 0
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package monitor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHistogramBucket(t *testing.T) {
+	for _, value := range []int64{0, 1, 15, 16, 31, 32, 33, 34, 100, 1000, 123456, 1 << 40} {
+		index := getHistogramBucketIndex(value)
+		upperBound := getHistogramBucketUpperBound(index)
+		assert.True(t, upperBound >= value)
+		// relative error is less than 1/16
+		assert.True(t, float64(upperBound-value) <= float64(value)/histogramSubBuckets)
+		if index > 0 {
+			assert.True(t, getHistogramBucketUpperBound(index-1) < value)
+		}
+	}
+}
+
+func TestLatencyHistogram(t *testing.T) {
+	h := &latencyHistogram{}
+	assert.Equal(t, int64(0), h.percentile(0.5))
+	for i := int64(1); i <= 1000; i++ {
+		h.record(i)
+	}
+	assert.Equal(t, float32(500.5), h.average())
+	assert.InDelta(t, 500, h.percentile(0.5), 500/histogramSubBuckets)
+	assert.InDelta(t, 900, h.percentile(0.9), 900/histogramSubBuckets)
+	assert.InDelta(t, 990, h.percentile(0.99), 990/histogramSubBuckets)
+	assert.Equal(t, int64(1000), h.percentile(1))
+	assert.Equal(t, int64(1000), h.max)
+
+	h.reset()
+	assert.Equal(t, int64(0), h.percentile(0.99))
+	h.record(7)
+	assert.Equal(t, int64(7), h.percentile(0.99))
+}
@@ -16,6 +16,8 @@
 package monitor
 
 import (
+	"sync"
+
 	"github.com/alibaba/ioc-golang/aop"
 )
 
@@ -24,26 +26,50 @@ import (
 // +ioc:autowire:proxy:autoInjection=false
 
 type interceptorImpl struct {
-	monitorContext contextIOCInterface
+	// monitorContexts are contexts of monitor sessions, each of them has its own filter and interval
+	monitorContexts     []contextIOCInterface
+	monitorContextsLock sync.RWMutex
 }
 
 func (w *interceptorImpl) BeforeInvoke(ctx *aop.InvocationContext) {
-	if w.monitorContext != nil {
-		w.monitorContext.BeforeInvoke(ctx)
+	if m := getServingMetrics(); m != nil {
+		m.BeforeInvoke(ctx)
+	}
+	w.monitorContextsLock.RLock()
+	defer w.monitorContextsLock.RUnlock()
+	for _, monitorCtx := range w.monitorContexts {
+		monitorCtx.BeforeInvoke(ctx)
 	}
 }
 
 func (w *interceptorImpl) AfterInvoke(ctx *aop.InvocationContext) {
-	if w.monitorContext != nil {
-		w.monitorContext.AfterInvoke(ctx)
+	if m := getServingMetrics(); m != nil {
+		m.AfterInvoke(ctx)
+	}
+	w.monitorContextsLock.RLock()
+	defer w.monitorContextsLock.RUnlock()
+	for _, monitorCtx := range w.monitorContexts {
+		monitorCtx.AfterInvoke(ctx)
 	}
 }
 
+// Monitor starts a monitor session, which keeps running until StopMonitor is called with the same monitorCtx
 func (w *interceptorImpl) Monitor(monitorCtx contextIOCInterface) {
-	w.monitorContext = monitorCtx
+	w.monitorContextsLock.Lock()
+	defer w.monitorContextsLock.Unlock()
+	w.monitorContexts = append(w.monitorContexts, monitorCtx)
 }
 
-func (w *interceptorImpl) StopMonitor() {
-	w.monitorContext.Destroy()
-	w.monitorContext = nil
+// StopMonitor stops and destroys monitor session of monitorCtx, other sessions are not affected
+func (w *interceptorImpl) StopMonitor(monitorCtx contextIOCInterface) {
+	w.monitorContextsLock.Lock()
+	monitorContexts := make([]contextIOCInterface, 0, len(w.monitorContexts))
+	for _, c := range w.monitorContexts {
+		if c != monitorCtx {
+			monitorContexts = append(monitorContexts, c)
+		}
+	}
+	w.monitorContexts = monitorContexts
+	w.monitorContextsLock.Unlock()
+	monitorCtx.Destroy()
 }
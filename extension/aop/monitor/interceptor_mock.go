@@ -30,9 +30,9 @@ func (_m *mockInterceptorImplIOCInterface) Monitor(monitorCtx contextIOCInterfac
 	_m.Called(monitorCtx)
 }
 
-// StopMonitor provides a mock function with given fields:
-func (_m *mockInterceptorImplIOCInterface) StopMonitor() {
-	_m.Called()
+// StopMonitor provides a mock function with given fields: monitorCtx
+func (_m *mockInterceptorImplIOCInterface) StopMonitor(monitorCtx contextIOCInterface) {
+	_m.Called(monitorCtx)
 }
 
 // newMockInterceptorImplIOCInterface creates a new instance of mockInterceptorImplIOCInterface. It also registers the testing.TB interface on the mock and a cleanup function to assert the mocks expectations.
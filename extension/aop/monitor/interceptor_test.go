@@ -0,0 +1,133 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package monitor
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/aop"
+	monitorPB "github.com/alibaba/ioc-golang/extension/aop/monitor/api/ioc_golang/aop/monitor"
+)
+
+const testSDID = "github.com/alibaba/ioc-golang/extension/aop/monitor.testService"
+
+func newTestInvocationContext(methodName string, err error) *aop.InvocationContext {
+	ctx := &aop.InvocationContext{
+		ID:         uuid.New(),
+		SDID:       testSDID,
+		MethodName: methodName,
+		MethodType: reflect.TypeOf(func() error { return nil }),
+		Attempts:   1,
+	}
+	ctx.ReturnValues = ctx.ReturnValuesWithError(err)
+	return ctx
+}
+
+func TestInterceptorImplMultipleSessions(t *testing.T) {
+	interceptor := &interceptorImpl{}
+	newSession := func(methodName string) (*context, chan *monitorPB.MonitorResponse) {
+		ch := make(chan *monitorPB.MonitorResponse)
+		monitorCtx, err := Getcontext(&contextParam{
+			SDID:       testSDID,
+			MethodName: methodName,
+			Ch:         ch,
+			Period:     time.Millisecond * 50,
+		})
+		assert.Nil(t, err)
+		interceptor.Monitor(monitorCtx)
+		return monitorCtx, ch
+	}
+	invoke := func(methodName string) {
+		ctx := newTestInvocationContext(methodName, nil)
+		interceptor.BeforeInvoke(ctx)
+		interceptor.AfterInvoke(ctx)
+	}
+	getMethods := func(rsp *monitorPB.MonitorResponse) []string {
+		methods := make([]string, 0)
+		for _, item := range rsp.GetMonitorResponseItems() {
+			methods = append(methods, item.GetMethod())
+		}
+		return methods
+	}
+
+	ctxA, chA := newSession("A")
+	ctxAll, chAll := newSession("")
+	invoke("A")
+	invoke("B")
+	assert.Equal(t, []string{"A"}, getMethods(<-chA))
+	assert.Equal(t, []string{"A", "B"}, getMethods(<-chAll))
+
+	// stopping one session doesn't affect the other one
+	interceptor.StopMonitor(ctxA)
+	invoke("B")
+	assert.Equal(t, []string{"B"}, getMethods(<-chAll))
+	assert.Equal(t, 1, len(interceptor.monitorContexts))
+
+	interceptor.StopMonitor(ctxAll)
+	assert.Equal(t, 0, len(interceptor.monitorContexts))
+}
+
+func TestMethodInvocationRecord(t *testing.T) {
+	record, err := GetmethodInvocationRecord()
+	assert.Nil(t, err)
+
+	inFlightCtx := newTestInvocationContext("Call", nil)
+	record.BeforeRequest(inFlightCtx)
+	for i := 0; i < 3; i++ {
+		ctx := newTestInvocationContext("Call", errors.New("timeout"))
+		record.BeforeRequest(ctx)
+		record.AfterRequest(ctx)
+	}
+	ctx := newTestInvocationContext("Call", errors.New("not found"))
+	record.BeforeRequest(ctx)
+	record.AfterRequest(ctx)
+	ctx = newTestInvocationContext("Call", nil)
+	record.BeforeRequest(ctx)
+	time.Sleep(time.Millisecond * 10)
+	record.AfterRequest(ctx)
+
+	item := record.DescribeAndReset()
+	assert.Equal(t, int64(5), item.GetTotal())
+	assert.Equal(t, int64(4), item.GetFail())
+	assert.Equal(t, int64(1), item.GetInFlight())
+	assert.True(t, item.GetMaxRT() >= 10000)
+	assert.True(t, item.GetP99RT() <= item.GetMaxRT())
+	assert.True(t, item.GetP50RT() <= item.GetP90RT())
+	assert.Equal(t, []*monitorPB.ErrorCount{
+		{Message: "timeout", Count: 3},
+		{Message: "not found", Count: 1},
+	}, item.GetErrors())
+
+	// distinct error messages are limited
+	for i := 0; i < maxErrorMessages+5; i++ {
+		ctx := newTestInvocationContext("Call", fmt.Errorf("error %d", i))
+		record.BeforeRequest(ctx)
+		record.AfterRequest(ctx)
+	}
+	record.AfterRequest(inFlightCtx)
+	item = record.DescribeAndReset()
+	assert.Equal(t, int64(0), item.GetInFlight())
+	assert.Equal(t, maxErrorMessages+1, len(item.GetErrors()))
+	assert.Equal(t, otherErrorsMessage, item.GetErrors()[0].GetMessage())
+	assert.Equal(t, int64(5), item.GetErrors()[0].GetCount())
+}
@@ -0,0 +1,163 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package monitor
+
+import (
+	oriCtx "context"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+const (
+	metricsNamespace = "ioc_golang"
+
+	resultSuccess = "success"
+	resultFail    = "fail"
+
+	metricsShutdownTimeout = time.Second * 5
+)
+
+var metricsLabels = []string{"autowire_type", "sdid", "method"}
+
+// metrics records invocations of all proxied methods, which are kept all the time and served in prometheus text format
+type metrics struct {
+	registry *prometheus.Registry
+	total    *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+
+	startTimeMap sync.Map // invocation ID -> start time
+
+	// autowireTypesMap caches autowire types of sdid, sdid -> autowire types joined with ','
+	autowireTypesMap sync.Map
+
+	// server is set by serve, and shut down by stop
+	server *http.Server
+}
+
+func newMetrics(appName string, buckets []float64) *metrics {
+	if len(buckets) == 0 {
+		buckets = prometheus.DefBuckets
+	}
+	constLabels := prometheus.Labels{"app": appName}
+	m := &metrics{
+		registry: prometheus.NewRegistry(),
+		total: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace:   metricsNamespace,
+			Name:        "invocations_total",
+			Help:        "Count of invocations of proxied methods, with result 'success' or 'fail'.",
+			ConstLabels: constLabels,
+		}, append(metricsLabels, "result")),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace:   metricsNamespace,
+			Name:        "invocation_duration_seconds",
+			Help:        "Latency of invocations of proxied methods in seconds.",
+			ConstLabels: constLabels,
+			Buckets:     buckets,
+		}, metricsLabels),
+	}
+	m.registry.MustRegister(
+		m.total,
+		m.duration,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+	return m
+}
+
+func (m *metrics) BeforeInvoke(ctx *aop.InvocationContext) {
+	m.startTimeMap.Store(ctx.ID, time.Now())
+}
+
+func (m *metrics) AfterInvoke(ctx *aop.InvocationContext) {
+	val, ok := m.startTimeMap.LoadAndDelete(ctx.ID)
+	if !ok {
+		return
+	}
+	duration := time.Since(val.(time.Time))
+	autowireType := m.getAutowireType(ctx.SDID)
+	result := resultSuccess
+	if isFailed, _ := common.IsInvocationFailed(ctx.ReturnValues); isFailed || ctx.Panic != nil {
+		result = resultFail
+	}
+	m.total.WithLabelValues(autowireType, ctx.SDID, ctx.MethodName, result).Inc()
+	m.duration.WithLabelValues(autowireType, ctx.SDID, ctx.MethodName).Observe(duration.Seconds())
+}
+
+// getAutowireType returns autowire types that sdid is registered to, like 'normal,singleton'
+func (m *metrics) getAutowireType(sdid string) string {
+	if val, ok := m.autowireTypesMap.Load(sdid); ok {
+		return val.(string)
+	}
+	autowireTypes := make([]string, 0)
+	for autowireType, aw := range autowire.GetAllWrapperAutowires() {
+		if _, ok := aw.GetAllStructDescriptors()[sdid]; ok {
+			autowireTypes = append(autowireTypes, autowireType)
+		}
+	}
+	sort.Strings(autowireTypes)
+	joinedAutowireTypes := strings.Join(autowireTypes, ",")
+	m.autowireTypesMap.Store(sdid, joinedAutowireTypes)
+	return joinedAutowireTypes
+}
+
+func (m *metrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// serve serves metrics at local port and path in background, until stop is called
+func (m *metrics) serve(port, path string) error {
+	lst, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(path, m.handler())
+	server := &http.Server{Handler: mux}
+	m.server = server
+	logger.Blue("[AOP monitor] Metrics server listening at :%d%s", lst.Addr().(*net.TCPAddr).Port, path)
+	go func() {
+		if err := server.Serve(lst); err != nil && err != http.ErrServerClosed {
+			logger.Red("[AOP monitor] Metrics server stopped with error = %s", err)
+		}
+	}()
+	return nil
+}
+
+// stop shuts down metrics server started by serve, and releases its port
+func (m *metrics) stop() {
+	if m.server == nil {
+		return
+	}
+	ctx, cancel := oriCtx.WithTimeout(oriCtx.Background(), metricsShutdownTimeout)
+	defer cancel()
+	if err := m.server.Shutdown(ctx); err != nil {
+		logger.Red("[AOP monitor] Shutdown metrics server failed, error = %s", err)
+	}
+	m.server = nil
+}
@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package monitor
+
+import (
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/autowire/singleton"
+)
+
+type metricsTestStruct struct {
+}
+
+func TestMetrics(t *testing.T) {
+	sd := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &metricsTestStruct{}
+		},
+	}
+	singleton.RegisterStructDescriptor(sd)
+	sdid := sd.ID()
+
+	m := newMetrics("test-app", []float64{0.1, 1})
+	invoke := func(err error) {
+		ctx := &aop.InvocationContext{
+			ID:         uuid.New(),
+			SDID:       sdid,
+			MethodName: "Call",
+			MethodType: reflect.TypeOf(func() error { return nil }),
+		}
+		m.BeforeInvoke(ctx)
+		ctx.ReturnValues = ctx.ReturnValuesWithError(err)
+		m.AfterInvoke(ctx)
+	}
+	invoke(nil)
+	invoke(nil)
+	invoke(errors.New("failed"))
+
+	server := httptest.NewServer(m.handler())
+	defer server.Close()
+	rsp, err := server.Client().Get(server.URL)
+	assert.Nil(t, err)
+	defer rsp.Body.Close()
+	body, err := ioutil.ReadAll(rsp.Body)
+	assert.Nil(t, err)
+	text := string(body)
+
+	labels := `app="test-app",autowire_type="singleton",method="Call"`
+	sdidLabel := `sdid="` + sdid + `"`
+	assert.True(t, strings.Contains(text, `ioc_golang_invocations_total{`+labels+`,result="success",`+sdidLabel+`} 2`))
+	assert.True(t, strings.Contains(text, `ioc_golang_invocations_total{`+labels+`,result="fail",`+sdidLabel+`} 1`))
+	assert.True(t, strings.Contains(text, `ioc_golang_invocation_duration_seconds_bucket{`+labels+`,`+sdidLabel+`,le="0.1"} 3`))
+	assert.True(t, strings.Contains(text, `ioc_golang_invocation_duration_seconds_count{`+labels+`,`+sdidLabel+`} 3`))
+	assert.True(t, strings.Contains(text, "go_goroutines"))
+}
+
+func TestLoadMetrics(t *testing.T) {
+	lst, err := net.Listen("tcp", ":0")
+	assert.Nil(t, err)
+	port := strconv.Itoa(lst.Addr().(*net.TCPAddr).Port)
+	assert.Nil(t, lst.Close())
+	get := func(path string) (int, error) {
+		rsp, err := http.Get("http://localhost:" + port + path)
+		if err != nil {
+			return 0, err
+		}
+		defer rsp.Body.Close()
+		return rsp.StatusCode, nil
+	}
+	defer stopMetrics()
+
+	loadMetrics("test-app", MetricsConfig{Enable: true, Port: port})
+	served := getServingMetrics()
+	assert.NotNil(t, served)
+	code, err := get(defaultMetricsPath)
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, code)
+
+	// loading the same config keeps the served metrics
+	loadMetrics("test-app", MetricsConfig{Enable: true, Port: port, Path: defaultMetricsPath})
+	assert.Same(t, served, getServingMetrics())
+
+	// the port is released and served again with new config
+	loadMetrics("test-app", MetricsConfig{Enable: true, Port: port, Path: "/new-metrics"})
+	assert.NotSame(t, served, getServingMetrics())
+	code, err = get("/new-metrics")
+	assert.Nil(t, err)
+	assert.Equal(t, http.StatusOK, code)
+
+	stopMetrics()
+	assert.Nil(t, getServingMetrics())
+	_, err = get("/new-metrics")
+	assert.NotNil(t, err)
+
+	loadMetrics("test-app", MetricsConfig{Enable: false, Port: port})
+	assert.Nil(t, getServingMetrics())
+}
@@ -53,13 +53,14 @@ func (w *monitorService) Monitor(req *monitorPB.MonitorRequest, svr monitorPB.Mo
 		return err
 	}
 	w.MonitorInterceptor.Monitor(monitorCtx)
+	// stop the session when client disconnects or sending fails, other sessions are not affected
+	defer w.MonitorInterceptor.StopMonitor(monitorCtx)
 
 	done := svr.Context().Done()
 	for {
 		select {
 		case <-done:
 			// monitor stop
-			w.MonitorInterceptor.StopMonitor()
 			return nil
 		case monitorRsp := <-sendCh:
 			if err := svr.Send(monitorRsp); err != nil {
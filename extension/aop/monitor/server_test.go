@@ -82,7 +82,9 @@ func TestMonitorServiceImpl(t *testing.T) {
 			}()
 		})
 
-		mockInterceptorImpl.On("StopMonitor").Once()
+		mockInterceptorImpl.On("StopMonitor", mock.MatchedBy(func(monitorCtx *context) bool {
+			return monitorCtx.sdid == sdid && monitorCtx.methodName == method
+		})).Once()
 
 		// 2. register mock interceptor impl to replace original struct register
 		// Just copy from mock struct 'interceptorImpl', copy it's generated registry code from zz_generated.ioc.go,
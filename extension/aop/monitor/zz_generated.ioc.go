@@ -118,12 +118,12 @@ func (c *context_) Destroy() {
 }
 
 type methodInvocationRecord_ struct {
-	DescribeAndReset_ func() (int, int, int, float32, float32)
+	DescribeAndReset_ func() *aopmonitor.MonitorResponseItem
 	BeforeRequest_    func(ctx *aop.InvocationContext)
 	AfterRequest_     func(ctx *aop.InvocationContext)
 }
 
-func (m *methodInvocationRecord_) DescribeAndReset() (int, int, int, float32, float32) {
+func (m *methodInvocationRecord_) DescribeAndReset() *aopmonitor.MonitorResponseItem {
 	return m.DescribeAndReset_()
 }
 
@@ -139,7 +139,7 @@ type interceptorImpl_ struct {
 	BeforeInvoke_ func(ctx *aop.InvocationContext)
 	AfterInvoke_  func(ctx *aop.InvocationContext)
 	Monitor_      func(monitorCtx contextIOCInterface)
-	StopMonitor_  func()
+	StopMonitor_  func(monitorCtx contextIOCInterface)
 }
 
 func (i *interceptorImpl_) BeforeInvoke(ctx *aop.InvocationContext) {
@@ -154,8 +154,8 @@ func (i *interceptorImpl_) Monitor(monitorCtx contextIOCInterface) {
 	i.Monitor_(monitorCtx)
 }
 
-func (i *interceptorImpl_) StopMonitor() {
-	i.StopMonitor_()
+func (i *interceptorImpl_) StopMonitor(monitorCtx contextIOCInterface) {
+	i.StopMonitor_(monitorCtx)
 }
 
 type monitorService_ struct {
@@ -173,7 +173,7 @@ type contextIOCInterface interface {
 }
 
 type methodInvocationRecordIOCInterface interface {
-	DescribeAndReset() (int, int, int, float32, float32)
+	DescribeAndReset() *aopmonitor.MonitorResponseItem
 	BeforeRequest(ctx *aop.InvocationContext)
 	AfterRequest(ctx *aop.InvocationContext)
 }
@@ -182,7 +182,7 @@ type interceptorImplIOCInterface interface {
 	BeforeInvoke(ctx *aop.InvocationContext)
 	AfterInvoke(ctx *aop.InvocationContext)
 	Monitor(monitorCtx contextIOCInterface)
-	StopMonitor()
+	StopMonitor(monitorCtx contextIOCInterface)
 }
 
 type monitorServiceIOCInterface interface {
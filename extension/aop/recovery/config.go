@@ -13,16 +13,16 @@
  * limitations under the License.
  */
 
-package monitor
+package recovery
 
-func getAverageInt64(input []int64) float32 {
-	length := len(input)
-	if length == 0 {
-		return 0
-	}
-	sum := int64(0)
-	for _, v := range input {
-		sum += v
-	}
-	return float32(sum) / float32(length)
+/*
+RecoverConfig is config of recover AOP, which is disabled by default, like:
+
+	ioc-golang:
+	  aop:
+	    recover:
+	      enable: true
+*/
+type RecoverConfig struct {
+	Enable bool `yaml:"enable"`
 }
@@ -0,0 +1,52 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recovery
+
+import (
+	"reflect"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:proxy=false
+
+/*
+interceptorImpl recovers panic of proxied method. If the last return type of method is error, the panic is converted
+to *aop.PanicError and returned, otherwise it panics again after the panic is set to invocation context, which can be
+read by AfterInvoke of other interceptors.
+*/
+type interceptorImpl struct {
+}
+
+func (i *interceptorImpl) Invoke(ctx *aop.InvocationContext, next func() []reflect.Value) (returnValues []reflect.Value) {
+	defer func() {
+		r := recover()
+		if r == nil {
+			return
+		}
+		ctx.Panic = aop.NewPanicError(r)
+		logger.Red("[AOP recover] %s.%s() panics, %+v", ctx.SDID, ctx.MethodName, ctx.Panic)
+		returnValues = ctx.ReturnValuesWithError(ctx.Panic)
+		ctx.SetReturnValues(returnValues)
+		if !ctx.ReturnsError() {
+			panic(r)
+		}
+	}()
+	return next()
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package recovery
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/aop"
+)
+
+func TestInterceptorImpl_Invoke(t *testing.T) {
+	panicErr := errors.New("panic error")
+	tests := []struct {
+		name       string
+		method     interface{}
+		panicValue interface{}
+		wantPanic  bool
+	}{
+		{
+			name:       "convert panic to error",
+			method:     func() (string, error) { return "", nil },
+			panicValue: "panic message",
+		},
+		{
+			name:       "convert panic to error wrapping panic error",
+			method:     func() error { return nil },
+			panicValue: panicErr,
+		},
+		{
+			name:       "panic again without error return",
+			method:     func() string { return "" },
+			panicValue: "panic message",
+			wantPanic:  true,
+		},
+		{
+			name:       "panic again without return",
+			method:     func() {},
+			panicValue: "panic message",
+			wantPanic:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			i := &interceptorImpl{}
+			ctx := &aop.InvocationContext{
+				MethodType: reflect.TypeOf(tt.method),
+			}
+			next := func() []reflect.Value {
+				panic(tt.panicValue)
+			}
+			if tt.wantPanic {
+				assert.PanicsWithValue(t, tt.panicValue, func() {
+					i.Invoke(ctx, next)
+				})
+				assert.Equal(t, tt.panicValue, ctx.Panic.Value)
+				assert.Equal(t, ctx.MethodType.NumOut(), len(ctx.ReturnValues))
+				return
+			}
+
+			returnValues := i.Invoke(ctx, next)
+			assert.Equal(t, ctx.MethodType.NumOut(), len(returnValues))
+			err, ok := returnValues[len(returnValues)-1].Interface().(error)
+			assert.True(t, ok)
+			assert.Equal(t, ctx.Panic, err)
+			assert.Equal(t, fmt.Sprintf("panic: %v", tt.panicValue), err.Error())
+			assert.Contains(t, fmt.Sprintf("%+v", err), "interceptor_test.go")
+			if panicValueErr, ok := tt.panicValue.(error); ok {
+				assert.True(t, errors.Is(err, panicValueErr))
+			}
+		})
+	}
+
+	t.Run("no panic", func(t *testing.T) {
+		ctx := &aop.InvocationContext{
+			MethodType: reflect.TypeOf(func() error { return nil }),
+		}
+		returnValues := (&interceptorImpl{}).Invoke(ctx, func() []reflect.Value {
+			return []reflect.Value{reflect.Zero(ctx.MethodType.Out(0))}
+		})
+		assert.Nil(t, ctx.Panic)
+		assert.True(t, returnValues[0].IsNil())
+	})
+}
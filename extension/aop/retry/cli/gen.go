@@ -0,0 +1,47 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package cli
+
+import (
+	"sigs.k8s.io/controller-tools/pkg/markers"
+
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin/common"
+)
+
+const retryAnnotation = "ioc:aop:retry"
+
+// +ioc:autowire=true
+// +ioc:autowire:type=allimpls
+// +ioc:autowire:proxy=false
+// +ioc:autowire:implements=github.com/alibaba/ioc-golang/iocli/gen/marker.DefinitionGetter
+
+type retryMarker struct {
+}
+
+func (m *retryMarker) GetMarkerDefinition() *markers.Definition {
+	return common.NewMethodMarkerDefinition(retryAnnotation, retryMarkerArgs{})
+}
+
+// retryMarkerArgs is args of marker '+ioc:aop:retry:method=GetUser,maxAttempts=5,backoff=50ms,retryOn=timeout'
+type retryMarkerArgs struct {
+	Method      string   `marker:"method"`
+	MaxAttempts int      `marker:"maxAttempts,optional" rule:"max-attempts"`
+	Backoff     string   `marker:"backoff,optional" rule:"backoff"`
+	MaxBackoff  string   `marker:"maxBackoff,optional" rule:"max-backoff"`
+	Multiplier  float64  `marker:"multiplier,optional" rule:"multiplier"`
+	Jitter      *float64 `marker:"jitter,optional" rule:"jitter"`
+	RetryOn     []string `marker:"retryOn,optional" rule:"retry-on"`
+}
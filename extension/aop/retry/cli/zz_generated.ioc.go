@@ -0,0 +1,65 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by iocli, run 'iocli gen' to re-generate
+
+package cli
+
+import (
+	autowire "github.com/alibaba/ioc-golang/autowire"
+	allimpls "github.com/alibaba/ioc-golang/extension/autowire/allimpls"
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin"
+	marker "github.com/alibaba/ioc-golang/iocli/gen/marker"
+)
+
+func init() {
+	retryMarkerStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &retryMarker{}
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(marker.DefinitionGetter),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(retryMarkerStructDescriptor)
+	var _ marker.DefinitionGetter = &retryMarker{}
+	retryCodeGenerationPluginStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &retryCodeGenerationPlugin{}
+		},
+		ConstructFunc: func(i interface{}, _ interface{}) (interface{}, error) {
+			impl := i.(*retryCodeGenerationPlugin)
+			var constructFunc retryCodeGenerationPluginConstructFunc = create
+			return constructFunc(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"allimpls": map[string]interface{}{
+					"autowireType": "normal",
+				},
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(plugin.CodeGeneratorPluginForOneStruct),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(retryCodeGenerationPluginStructDescriptor)
+	var _ plugin.CodeGeneratorPluginForOneStruct = &retryCodeGenerationPlugin{}
+}
+
+type retryCodeGenerationPluginConstructFunc func(impl *retryCodeGenerationPlugin) (*retryCodeGenerationPlugin, error)
+
+var _retryMarkerSDID string
+var _retryCodeGenerationPluginSDID string
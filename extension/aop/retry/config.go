@@ -0,0 +1,90 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultBackoff     = 100 * time.Millisecond
+	defaultMaxBackoff  = 10 * time.Second
+	defaultMultiplier  = 2
+	defaultJitter      = 0.2
+)
+
+/*
+Policy is retry policy of one method, which can be set by marker '+ioc:aop:retry', like:
+
+	// +ioc:aop:retry:method=GetUser,maxAttempts=5,backoff=50ms,retryOn=timeout
+
+or by config 'ioc-golang.aop.retry.<sdid>.<method>', which overwrites fields set by marker, including zero values:
+
+	ioc-golang:
+	  aop:
+	    retry:
+	      github.com/my/app/service.UserService:
+	        GetUser:
+	          max-attempts: 5
+	          backoff: 50ms
+	          max-backoff: 1s
+	          retry-on:
+	            - timeout
+	            - "connection (refused|reset)"
+*/
+type Policy struct {
+	// MaxAttempts is max count of calling the method, including the first one, default 3
+	MaxAttempts int `yaml:"max-attempts"`
+	// Backoff is wait duration before the first retry, default 100ms
+	Backoff time.Duration `yaml:"backoff"`
+	// MaxBackoff limits wait duration between retries, default 10s
+	MaxBackoff time.Duration `yaml:"max-backoff"`
+	// Multiplier is multiplied to wait duration after each retry, default 2
+	Multiplier float64 `yaml:"multiplier"`
+	// Jitter randomizes wait duration in range of [1-Jitter, 1+Jitter] times, default 0.2, 0 means no jitter
+	Jitter float64 `yaml:"jitter"`
+	// RetryOn are names of error matchers registered by RegisterErrorMatcher, or regular expressions of error
+	// message, failed invocation is retried if any of them matches. Empty means all failed invocations are retried.
+	RetryOn []string `yaml:"retry-on"`
+}
+
+// RetryConfig is config under 'ioc-golang.aop.retry', sdid -> method name -> configured fields of policy, which are
+// kept as raw values, so that only configured fields overwrite the policy
+type RetryConfig map[string]map[string]map[string]interface{}
+
+func newDefaultPolicy() *Policy {
+	return &Policy{
+		MaxAttempts: defaultMaxAttempts,
+		Backoff:     defaultBackoff,
+		MaxBackoff:  defaultMaxBackoff,
+		Multiplier:  defaultMultiplier,
+		Jitter:      defaultJitter,
+	}
+}
+
+// getBackoff returns wait duration before the retry-th retry, which starts from 1
+func (p *Policy) getBackoff(retry int, random float64) time.Duration {
+	backoff := float64(p.Backoff)
+	for i := 1; i < retry && backoff < float64(p.MaxBackoff); i++ {
+		backoff *= p.Multiplier
+	}
+	if backoff > float64(p.MaxBackoff) {
+		backoff = float64(p.MaxBackoff)
+	}
+	// random is in [0, 1)
+	return time.Duration(backoff * (1 + p.Jitter*(2*random-1)))
+}
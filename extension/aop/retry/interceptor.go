@@ -0,0 +1,83 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"time"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:proxy=false
+
+/*
+interceptorImpl calls proxied method again with backoff if invocation fails, which is decided by IsInvocationFailed
+of return values, until the invocation succeeds, max attempts is reached, the error is not matched by retryOn, or
+the context.Context param is done or would exceed its deadline before the next attempt.
+*/
+type interceptorImpl struct {
+}
+
+func (i *interceptorImpl) Invoke(ctx *aop.InvocationContext, next func() []reflect.Value) []reflect.Value {
+	policy := getMethodPolicy(ctx.SDID, ctx.MethodName)
+	if policy == nil {
+		return next()
+	}
+	for attempt := 1; ; attempt++ {
+		returnValues := next()
+		failed, err := common.IsInvocationFailed(returnValues)
+		if !failed || attempt >= policy.MaxAttempts || !policy.shouldRetry(err) {
+			return returnValues
+		}
+		backoff := policy.getBackoff(attempt, rand.Float64())
+		if !waitBackoff(ctx.Context, backoff) {
+			logger.Red("[AOP retry] Give up retrying %s.%s() after %d attempts, context is done or deadline is "+
+				"exceeded, error = %s", ctx.SDID, ctx.MethodName, attempt, err)
+			return returnValues
+		}
+		logger.Cyan("[AOP retry] Retry %s.%s() after %s, attempt %d failed, error = %s",
+			ctx.SDID, ctx.MethodName, backoff, attempt, err)
+	}
+}
+
+// waitBackoff waits for backoff, and returns false if ctx is done, or its deadline is earlier than end of backoff
+func waitBackoff(ctx context.Context, backoff time.Duration) bool {
+	if ctx == nil {
+		time.Sleep(backoff)
+		return true
+	}
+	if ctx.Err() != nil {
+		return false
+	}
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < backoff {
+		return false
+	}
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
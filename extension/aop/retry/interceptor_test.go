@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+)
+
+const testSDID = "github.com/alibaba/ioc-golang/extension/aop/retry.testService"
+
+func TestInterceptorImpl_Invoke(t *testing.T) {
+	common.SetConfigForTest(t, &retryConfig, RetryConfig{
+		testSDID: {
+			"Call": {
+				"max-attempts": 3,
+				"backoff":      "1ms",
+			},
+			"CallTimeout": {
+				"max-attempts": 3,
+				"backoff":      "1ms",
+				"retry-on":     []string{TimeoutErrorMatcherName, "^connection refused$"},
+			},
+			"CallSlowly": {
+				"max-attempts": 3,
+				"backoff":      "1s",
+			},
+		},
+	}, resetMethodPolicies)
+
+	methodType := reflect.TypeOf(func() error { return nil })
+	newNext := func(ctx *aop.InvocationContext, errs ...error) (func() []reflect.Value, *int) {
+		calls := 0
+		return func() []reflect.Value {
+			calls++
+			ctx.Attempts++
+			var err error
+			if calls <= len(errs) {
+				err = errs[calls-1]
+			}
+			return ctx.ReturnValuesWithError(err)
+		}, &calls
+	}
+
+	tests := []struct {
+		name       string
+		methodName string
+		errs       []error
+		context    func() (context.Context, context.CancelFunc)
+		wantCalls  int
+		wantErr    bool
+	}{
+		{
+			name:       "success without retry",
+			methodName: "Call",
+			wantCalls:  1,
+		},
+		{
+			name:       "success after retry",
+			methodName: "Call",
+			errs:       []error{errors.New("failed"), errors.New("failed")},
+			wantCalls:  3,
+		},
+		{
+			name:       "fail after max attempts",
+			methodName: "Call",
+			errs:       []error{errors.New("failed"), errors.New("failed"), errors.New("failed")},
+			wantCalls:  3,
+			wantErr:    true,
+		},
+		{
+			name:       "method without policy",
+			methodName: "NotRetried",
+			errs:       []error{errors.New("failed")},
+			wantCalls:  1,
+			wantErr:    true,
+		},
+		{
+			name:       "retry matched error",
+			methodName: "CallTimeout",
+			errs:       []error{context.DeadlineExceeded, errors.New("connection refused")},
+			wantCalls:  3,
+		},
+		{
+			name:       "not retry unmatched error",
+			methodName: "CallTimeout",
+			errs:       []error{errors.New("connection refused by peer")},
+			wantCalls:  1,
+			wantErr:    true,
+		},
+		{
+			name:       "not retry if backoff exceeds context deadline",
+			methodName: "CallSlowly",
+			errs:       []error{errors.New("failed")},
+			context: func() (context.Context, context.CancelFunc) {
+				return context.WithTimeout(context.Background(), time.Millisecond*100)
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+		{
+			name:       "not retry if context is canceled",
+			methodName: "Call",
+			errs:       []error{errors.New("failed")},
+			context: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				cancel()
+				return ctx, cancel
+			},
+			wantCalls: 1,
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := &aop.InvocationContext{
+				SDID:       testSDID,
+				MethodName: tt.methodName,
+				MethodType: methodType,
+			}
+			if tt.context != nil {
+				c, cancel := tt.context()
+				defer cancel()
+				ctx.Context = c
+			}
+			next, calls := newNext(ctx, tt.errs...)
+			returnValues := (&interceptorImpl{}).Invoke(ctx, next)
+			assert.Equal(t, tt.wantCalls, *calls)
+			assert.Equal(t, tt.wantCalls, ctx.Attempts)
+			assert.Equal(t, tt.wantErr, !returnValues[0].IsNil())
+		})
+	}
+}
+
+func TestPolicy_getBackoff(t *testing.T) {
+	p := newDefaultPolicy()
+	assert.Equal(t, 100*time.Millisecond, p.getBackoff(1, 0.5))
+	assert.Equal(t, 200*time.Millisecond, p.getBackoff(2, 0.5))
+	assert.Equal(t, 80*time.Millisecond, p.getBackoff(1, 0))
+	assert.Equal(t, 10*time.Second, p.getBackoff(100, 0.5))
+}
+
+func TestParsePolicyFromSDMetadata(t *testing.T) {
+	sd := &autowire.StructDescriptor{
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{
+				"retry": map[string]map[string]interface{}{
+					"Call": {
+						"max-attempts": 5,
+						"backoff":      "50ms",
+						"retry-on":     []string{"timeout"},
+						"jitter":       0.0,
+					},
+				},
+			},
+		},
+	}
+	assert.False(t, parsePolicyFromSDMetadata(sd, "NotRetried", newDefaultPolicy()))
+	policy := newDefaultPolicy()
+	assert.True(t, parsePolicyFromSDMetadata(sd, "Call", policy))
+	assert.Equal(t, &Policy{
+		MaxAttempts: 5,
+		Backoff:     50 * time.Millisecond,
+		MaxBackoff:  defaultMaxBackoff,
+		Multiplier:  defaultMultiplier,
+		Jitter:      0,
+		RetryOn:     []string{"timeout"},
+	}, policy)
+}
+
+func TestNewMethodPolicy(t *testing.T) {
+	common.SetConfigForTest(t, &retryConfig, RetryConfig{
+		testSDID: {
+			"Call": {
+				"backoff": "10ms",
+				"jitter":  0,
+			},
+			"CallWithDefault": nil,
+		},
+	}, resetMethodPolicies)
+
+	policy := newMethodPolicy(testSDID, "Call")
+	assert.Equal(t, 0.0, policy.Jitter)
+	assert.Equal(t, defaultMaxAttempts, policy.MaxAttempts)
+	assert.Equal(t, 10*time.Millisecond, policy.getBackoff(1, 0))
+	assert.Equal(t, newDefaultPolicy(), newMethodPolicy(testSDID, "CallWithDefault").Policy)
+	assert.Nil(t, newMethodPolicy(testSDID, "NotRetried"))
+}
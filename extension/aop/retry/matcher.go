@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sync"
+
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// ErrorMatcher returns true if the error should be retried
+type ErrorMatcher func(err error) bool
+
+const TimeoutErrorMatcherName = "timeout"
+
+var (
+	errorMatchers     = make(map[string]ErrorMatcher)
+	errorMatchersLock sync.RWMutex
+)
+
+func init() {
+	RegisterErrorMatcher(TimeoutErrorMatcherName, func(err error) bool {
+		var timeoutErr interface {
+			Timeout() bool
+		}
+		if errors.As(err, &timeoutErr) {
+			return timeoutErr.Timeout()
+		}
+		return errors.Is(err, context.DeadlineExceeded)
+	})
+}
+
+// RegisterErrorMatcher registers error matcher with name, which can be referred by 'retryOn' of retry policy
+func RegisterErrorMatcher(name string, matcher ErrorMatcher) {
+	errorMatchersLock.Lock()
+	defer errorMatchersLock.Unlock()
+	errorMatchers[name] = matcher
+}
+
+func getErrorMatcher(name string) (ErrorMatcher, bool) {
+	errorMatchersLock.RLock()
+	defer errorMatchersLock.RUnlock()
+	matcher, ok := errorMatchers[name]
+	return matcher, ok
+}
+
+// compileErrorMatchers converts retryOn of policy to error matchers, nil means all errors are retried
+func compileErrorMatchers(retryOn []string) []ErrorMatcher {
+	if len(retryOn) == 0 {
+		return nil
+	}
+	matchers := make([]ErrorMatcher, 0, len(retryOn))
+	for _, r := range retryOn {
+		if matcher, ok := getErrorMatcher(r); ok {
+			matchers = append(matchers, matcher)
+			continue
+		}
+		pattern, err := regexp.Compile(r)
+		if err != nil {
+			logger.Red("[AOP retry] Invalid retryOn %s, which is neither error matcher name nor regular expression, error = %s", r, err)
+			continue
+		}
+		matchers = append(matchers, func(err error) bool {
+			return pattern.MatchString(err.Error())
+		})
+	}
+	return matchers
+}
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package retry
+
+import (
+	"sync"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// methodPolicy is policy of one method decoded from defaults, marker and config in order, with retryOn compiled
+type methodPolicy struct {
+	*Policy
+	errorMatchers []ErrorMatcher
+}
+
+// shouldRetry returns if failed invocation with err should be retried
+func (p *methodPolicy) shouldRetry(err error) bool {
+	if p.errorMatchers == nil {
+		return true
+	}
+	for _, matcher := range p.errorMatchers {
+		if matcher(err) {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	methodPolicies     = make(map[string]*methodPolicy)
+	methodPoliciesLock sync.RWMutex
+)
+
+func resetMethodPolicies() {
+	methodPoliciesLock.Lock()
+	defer methodPoliciesLock.Unlock()
+	methodPolicies = make(map[string]*methodPolicy)
+}
+
+// getMethodPolicy returns policy of method set by marker and config, or nil if the method should not be retried
+func getMethodPolicy(sdid, methodName string) *methodPolicy {
+	key := common.GetMethodUniqueKey(sdid, methodName)
+	methodPoliciesLock.RLock()
+	policy, ok := methodPolicies[key]
+	methodPoliciesLock.RUnlock()
+	if ok {
+		return policy
+	}
+
+	policy = newMethodPolicy(sdid, methodName)
+	methodPoliciesLock.Lock()
+	methodPolicies[key] = policy
+	methodPoliciesLock.Unlock()
+	return policy
+}
+
+func newMethodPolicy(sdid, methodName string) *methodPolicy {
+	policy := newDefaultPolicy()
+	marked := parsePolicyFromSDMetadata(autowire.GetStructDescriptor(sdid), methodName, policy)
+	configPolicy, configured := retryConfig[sdid][methodName]
+	if !marked && !configured {
+		return nil
+	}
+	if err := aop.DecodeMethodRule(configPolicy, policy); err != nil {
+		logger.Red("[AOP retry] Invalid retry config of %s.%s(), error = %s", sdid, methodName, err)
+	}
+	return &methodPolicy{
+		Policy:        policy,
+		errorMatchers: compileErrorMatchers(policy.RetryOn),
+	}
+}
+
+// parsePolicyFromSDMetadata parses policy generated from marker '+ioc:aop:retry' into policy, which is like
+// "retry": map[string]map[string]interface{}{"GetUser": {"max-attempts": 5}}, it returns false if method is not marked
+func parsePolicyFromSDMetadata(sd *autowire.StructDescriptor, methodName string, policy *Policy) bool {
+	if sd == nil {
+		return false
+	}
+	ok, err := aop.ParseMethodRuleFromSDMetadata(sd.Metadata, Name, methodName, policy)
+	if err != nil {
+		logger.Red("[AOP retry] Invalid retry marker of %s.%s(), error = %s", sd.ID(), methodName, err)
+	}
+	return ok
+}
@@ -0,0 +1,40 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by iocli, run 'iocli gen' to re-generate
+
+package retry
+
+import (
+	autowire "github.com/alibaba/ioc-golang/autowire"
+	singleton "github.com/alibaba/ioc-golang/autowire/singleton"
+	util "github.com/alibaba/ioc-golang/autowire/util"
+)
+
+func init() {
+	interceptorImplStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &interceptorImpl{}
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	singleton.RegisterStructDescriptor(interceptorImplStructDescriptor)
+}
+
+var _interceptorImplSDID string
+
+func GetinterceptorImplSingleton() (*interceptorImpl, error) {
+	if _interceptorImplSDID == "" {
+		_interceptorImplSDID = util.GetSDIDByStructPtr(new(interceptorImpl))
+	}
+	i, err := singleton.GetImpl(_interceptorImplSDID, nil)
+	if err != nil {
+		return nil, err
+	}
+	impl := i.(*interceptorImpl)
+	return impl, nil
+}
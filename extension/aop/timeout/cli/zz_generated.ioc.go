@@ -0,0 +1,65 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+// Code generated by iocli, run 'iocli gen' to re-generate
+
+package cli
+
+import (
+	autowire "github.com/alibaba/ioc-golang/autowire"
+	allimpls "github.com/alibaba/ioc-golang/extension/autowire/allimpls"
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin"
+	marker "github.com/alibaba/ioc-golang/iocli/gen/marker"
+)
+
+func init() {
+	timeoutMarkerStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &timeoutMarker{}
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(marker.DefinitionGetter),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(timeoutMarkerStructDescriptor)
+	var _ marker.DefinitionGetter = &timeoutMarker{}
+	timeoutCodeGenerationPluginStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &timeoutCodeGenerationPlugin{}
+		},
+		ConstructFunc: func(i interface{}, _ interface{}) (interface{}, error) {
+			impl := i.(*timeoutCodeGenerationPlugin)
+			var constructFunc timeoutCodeGenerationPluginConstructFunc = create
+			return constructFunc(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"allimpls": map[string]interface{}{
+					"autowireType": "normal",
+				},
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(plugin.CodeGeneratorPluginForOneStruct),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(timeoutCodeGenerationPluginStructDescriptor)
+	var _ plugin.CodeGeneratorPluginForOneStruct = &timeoutCodeGenerationPlugin{}
+}
+
+type timeoutCodeGenerationPluginConstructFunc func(impl *timeoutCodeGenerationPlugin) (*timeoutCodeGenerationPlugin, error)
+
+var _timeoutMarkerSDID string
+var _timeoutCodeGenerationPluginSDID string
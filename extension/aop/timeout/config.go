@@ -0,0 +1,32 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package timeout
+
+import (
+	"time"
+)
+
+/*
+TimeoutConfig is config under 'ioc-golang.aop.timeout', sdid -> method name -> timeout, which overwrites timeout set
+by marker '+ioc:aop:timeout:method=GetUser,timeout=500ms', like:
+
+	ioc-golang:
+	  aop:
+	    timeout:
+	      github.com/my/app/service.UserService:
+	        GetUser: 500ms
+*/
+type TimeoutConfig map[string]map[string]time.Duration
@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package timeout
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+// TimeoutError is returned by method that exceeds its timeout, which wraps context.DeadlineExceeded
+type TimeoutError struct {
+	SDID    string
+	Method  string
+	Timeout time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("call of %s.%s() exceeds timeout %s", e.SDID, e.Method, e.Timeout)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return context.DeadlineExceeded
+}
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:proxy=false
+
+/*
+interceptorImpl enforces timeout of method. If the first param of method is context.Context, the method is called
+with a context derived from it with the timeout, and if the derived context exceeds its deadline, return values are
+replaced by *TimeoutError as the last one, if the last return type is error. Otherwise, overruns are reported.
+*/
+type interceptorImpl struct {
+}
+
+func (i *interceptorImpl) Invoke(ctx *aop.InvocationContext, next func() []reflect.Value) []reflect.Value {
+	timeout := getMethodTimeout(ctx.SDID, ctx.MethodName)
+	if timeout <= 0 {
+		return next()
+	}
+	if ctx.MethodType == nil || ctx.MethodType.NumIn() == 0 || ctx.MethodType.In(0) != contextType {
+		start := time.Now()
+		returnValues := next()
+		if duration := time.Since(start); duration > timeout {
+			logger.Red("[AOP timeout] %s.%s() without context param exceeds timeout %s, costs %s",
+				ctx.SDID, ctx.MethodName, timeout, duration)
+		}
+		return returnValues
+	}
+
+	parent := ctx.Context
+	if parent == nil {
+		// nil context param is passed
+		parent = aop.WithInvocationCtx(context.Background(), ctx)
+	}
+	timeoutCtx, cancel := context.WithTimeout(parent, timeout)
+	defer cancel()
+	// restore context param after the call, so that outer interceptors like retry don't see the canceled context
+	originParam, originContext := ctx.Params[0], ctx.Context
+	defer func() {
+		ctx.Params[0], ctx.Context = originParam, originContext
+	}()
+	ctx.Params[0] = reflect.ValueOf(&timeoutCtx).Elem()
+	ctx.Context = timeoutCtx
+	returnValues := next()
+	if timeoutCtx.Err() == context.DeadlineExceeded && parent.Err() == nil {
+		if !ctx.ReturnsError() {
+			logger.Red("[AOP timeout] %s.%s() exceeds timeout %s, return values are kept as the method has no error return",
+				ctx.SDID, ctx.MethodName, timeout)
+			return returnValues
+		}
+		logger.Red("[AOP timeout] %s.%s() exceeds timeout %s", ctx.SDID, ctx.MethodName, timeout)
+		return ctx.ReturnValuesWithError(&TimeoutError{
+			SDID:    ctx.SDID,
+			Method:  ctx.MethodName,
+			Timeout: timeout,
+		})
+	}
+	return returnValues
+}
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package timeout
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/autowire/normal"
+	"github.com/alibaba/ioc-golang/extension/aop/retry"
+)
+
+const testSDID = "github.com/alibaba/ioc-golang/extension/aop/timeout.testService"
+
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+type testService struct {
+}
+
+func TestInterceptorImpl_Invoke(t *testing.T) {
+	common.SetConfigForTest(t, &timeoutConfig, TimeoutConfig{
+		testSDID: {
+			"Call":        time.Millisecond * 50,
+			"CallWithout": time.Millisecond * 50,
+		},
+	}, resetMethodTimeouts)
+
+	interceptor := &interceptorImpl{}
+	contextMethodType := reflect.TypeOf(func(ctx context.Context) (string, error) { return "", nil })
+	newContextCtx := func(methodName string, c context.Context) *aop.InvocationContext {
+		return &aop.InvocationContext{
+			SDID:       testSDID,
+			MethodName: methodName,
+			MethodType: contextMethodType,
+			Context:    c,
+			Params:     []reflect.Value{reflect.ValueOf(&c).Elem()},
+		}
+	}
+	// sleep simulates method respecting context param
+	sleep := func(ctx *aop.InvocationContext, d time.Duration) func() []reflect.Value {
+		return func() []reflect.Value {
+			c := ctx.Params[0].Interface().(context.Context)
+			select {
+			case <-time.After(d):
+				return []reflect.Value{reflect.ValueOf("done"), reflect.Zero(errorType)}
+			case <-c.Done():
+				return []reflect.Value{reflect.ValueOf(""), reflect.ValueOf(c.Err())}
+			}
+		}
+	}
+
+	t.Run("finish in time", func(t *testing.T) {
+		ctx := newContextCtx("Call", context.Background())
+		returnValues := interceptor.Invoke(ctx, sleep(ctx, 0))
+		assert.Equal(t, "done", returnValues[0].Interface())
+		assert.True(t, returnValues[1].IsNil())
+	})
+
+	t.Run("exceed timeout", func(t *testing.T) {
+		ctx := newContextCtx("Call", context.Background())
+		start := time.Now()
+		returnValues := interceptor.Invoke(ctx, sleep(ctx, time.Second))
+		assert.True(t, time.Since(start) < time.Millisecond*500)
+		err := returnValues[1].Interface().(error)
+		var timeoutErr *TimeoutError
+		assert.True(t, errors.As(err, &timeoutErr))
+		assert.Equal(t, time.Millisecond*50, timeoutErr.Timeout)
+		assert.True(t, errors.Is(err, context.DeadlineExceeded))
+	})
+
+	t.Run("nil context param", func(t *testing.T) {
+		ctx := newContextCtx("Call", nil)
+		ctx.Params = []reflect.Value{reflect.Zero(contextType)}
+		returnValues := interceptor.Invoke(ctx, func() []reflect.Value {
+			assert.Equal(t, ctx, aop.GetInvocationCtxFromContext(ctx.Context))
+			return sleep(ctx, time.Second)()
+		})
+		assert.True(t, errors.Is(returnValues[1].Interface().(error), context.DeadlineExceeded))
+		assert.Nil(t, ctx.Context)
+		assert.True(t, ctx.Params[0].IsNil())
+	})
+
+	t.Run("parent context is canceled", func(t *testing.T) {
+		c, cancel := context.WithCancel(context.Background())
+		cancel()
+		ctx := newContextCtx("Call", c)
+		returnValues := interceptor.Invoke(ctx, sleep(ctx, time.Second))
+		assert.Equal(t, context.Canceled, returnValues[1].Interface())
+	})
+
+	t.Run("restore context param after call", func(t *testing.T) {
+		c := context.Background()
+		ctx := newContextCtx("Call", c)
+		interceptor.Invoke(ctx, sleep(ctx, time.Second))
+		assert.Equal(t, c, ctx.Context)
+		assert.Equal(t, c, ctx.Params[0].Interface())
+	})
+
+	t.Run("method without context param", func(t *testing.T) {
+		ctx := &aop.InvocationContext{
+			SDID:       testSDID,
+			MethodName: "CallWithout",
+			MethodType: reflect.TypeOf(func() error { return nil }),
+		}
+		returnValues := interceptor.Invoke(ctx, func() []reflect.Value {
+			time.Sleep(time.Millisecond * 60)
+			return ctx.ReturnValuesWithError(nil)
+		})
+		assert.True(t, returnValues[0].IsNil())
+	})
+
+	t.Run("method without timeout", func(t *testing.T) {
+		ctx := newContextCtx("NotConfigured", context.Background())
+		interceptor.Invoke(ctx, sleep(ctx, 0))
+		_, ok := ctx.Context.Deadline()
+		assert.False(t, ok)
+	})
+}
+
+func TestInterceptorImpl_InvokeWithRetry(t *testing.T) {
+	common.SetConfigForTest(t, &timeoutConfig, TimeoutConfig{
+		testSDID: {
+			"Call": time.Millisecond * 50,
+		},
+	}, resetMethodTimeouts)
+	// retry is configured by marker of testService
+	normal.RegisterStructDescriptor(&autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &testService{}
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{
+				"retry": map[string]map[string]interface{}{
+					"Call": {"max-attempts": 3, "backoff": "1ms"},
+				},
+			},
+		},
+	})
+	retryInterceptor, err := retry.GetinterceptorImplSingleton()
+	assert.Nil(t, err)
+	timeoutInterceptor := &interceptorImpl{}
+
+	c := context.Background()
+	ctx := &aop.InvocationContext{
+		SDID:       testSDID,
+		MethodName: "Call",
+		MethodType: reflect.TypeOf(func(ctx context.Context) (string, error) { return "", nil }),
+		Context:    c,
+		Params:     []reflect.Value{reflect.ValueOf(&c).Elem()},
+	}
+	attempts := 0
+	// the first attempt exceeds timeout, and the retried one should be called with a new timeout context
+	returnValues := retryInterceptor.Invoke(ctx, func() []reflect.Value {
+		return timeoutInterceptor.Invoke(ctx, func() []reflect.Value {
+			attempts++
+			attemptCtx := ctx.Params[0].Interface().(context.Context)
+			if attempts == 1 {
+				<-attemptCtx.Done()
+				return []reflect.Value{reflect.ValueOf(""), reflect.ValueOf(attemptCtx.Err())}
+			}
+			if err := attemptCtx.Err(); err != nil {
+				return []reflect.Value{reflect.ValueOf(""), reflect.ValueOf(err)}
+			}
+			return []reflect.Value{reflect.ValueOf("done"), reflect.Zero(errorType)}
+		})
+	})
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, "done", returnValues[0].Interface())
+	assert.True(t, returnValues[1].IsNil())
+	assert.Equal(t, c, ctx.Context)
+}
+
+func TestParseTimeoutFromSDMetadata(t *testing.T) {
+	sd := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &testService{}
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{
+				"timeout": map[string]map[string]interface{}{
+					"Call": {
+						"timeout": "500ms",
+					},
+					"Invalid": {
+						"timeout": "invalid",
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, time.Millisecond*500, parseTimeoutFromSDMetadata(sd, "Call"))
+	assert.Equal(t, time.Duration(0), parseTimeoutFromSDMetadata(sd, "Invalid"))
+	assert.Equal(t, time.Duration(0), parseTimeoutFromSDMetadata(sd, "NotConfigured"))
+}
@@ -0,0 +1,76 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package timeout
+
+import (
+	"sync"
+	"time"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+var (
+	methodTimeouts     = make(map[string]time.Duration)
+	methodTimeoutsLock sync.RWMutex
+)
+
+func resetMethodTimeouts() {
+	methodTimeoutsLock.Lock()
+	defer methodTimeoutsLock.Unlock()
+	methodTimeouts = make(map[string]time.Duration)
+}
+
+// getMethodTimeout returns timeout of method set by config or marker, or 0 if the method has no timeout
+func getMethodTimeout(sdid, methodName string) time.Duration {
+	key := common.GetMethodUniqueKey(sdid, methodName)
+	methodTimeoutsLock.RLock()
+	timeout, ok := methodTimeouts[key]
+	methodTimeoutsLock.RUnlock()
+	if ok {
+		return timeout
+	}
+
+	timeout, ok = timeoutConfig[sdid][methodName]
+	if !ok {
+		timeout = parseTimeoutFromSDMetadata(autowire.GetStructDescriptor(sdid), methodName)
+	}
+	methodTimeoutsLock.Lock()
+	methodTimeouts[key] = timeout
+	methodTimeoutsLock.Unlock()
+	return timeout
+}
+
+// markerRule is rule of method set by marker '+ioc:aop:timeout:method=GetUser,timeout=500ms'
+type markerRule struct {
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+// parseTimeoutFromSDMetadata parses timeout generated from marker '+ioc:aop:timeout', which is like
+// "timeout": map[string]map[string]interface{}{"GetUser": {"timeout": "500ms"}}
+func parseTimeoutFromSDMetadata(sd *autowire.StructDescriptor, methodName string) time.Duration {
+	if sd == nil {
+		return 0
+	}
+	rule := &markerRule{}
+	if _, err := aop.ParseMethodRuleFromSDMetadata(sd.Metadata, Name, methodName, rule); err != nil {
+		logger.Red("[AOP timeout] Invalid timeout marker of %s.%s(), error = %s", sd.ID(), methodName, err)
+		return 0
+	}
+	return rule.Timeout
+}
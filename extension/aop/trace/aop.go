@@ -34,7 +34,8 @@ const Name = "trace"
 
 func init() {
 	aop.RegisterAOP(aop.AOP{
-		Name: Name,
+		Name:  Name,
+		Order: aop.OrderTrace,
 		InterceptorFactory: func() aop.Interceptor {
 			interceptor, _ := GettraceInterceptorIOCInterfaceSingleton()
 			// inject logger interceptor
@@ -52,6 +53,11 @@ func init() {
 			rpcService, _ := GettraceServiceImplSingleton()
 			tracePB.RegisterTraceServiceServer(server, rpcService)
 		},
+		StopFunc: func() {
+			if otelTracerImpl != nil {
+				otelTracerImpl.shutdown()
+			}
+		},
 		ConfigLoader: func(aopConfig *common.Config) {
 			if aopConfig.AppName != "" {
 				setAppName(aopConfig.AppName)
@@ -64,6 +70,14 @@ func init() {
 			if traceConfig.ValueDepth != 0 {
 				valueDepth = traceConfig.ValueDepth
 			}
+			if traceConfig.Tracer == TracerOTel {
+				otelTracer, err := newOTelTracer(appName, traceConfig.OTel)
+				if err != nil {
+					logger.Red("[AOP trace] Create OpenTelemetry tracer failed, error = %s", err)
+					return
+				}
+				otelTracerImpl = otelTracer
+			}
 		},
 	})
 }
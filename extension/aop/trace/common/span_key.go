@@ -3,6 +3,7 @@ package common
 const (
 	SpanParamsKey             = "params"
 	SpanReturnValuesKey       = "returnValues"
+	SpanPanicKey              = "panic"
 	DefaultRecordValuesDepth  = 5
 	DefaultRecordValuesLength = 1000
 )
@@ -15,7 +15,46 @@
 
 package trace
 
+const (
+	// TracerJaeger is the default tracer, spans are only created for invocations traced by 'iocli trace' or rpc
+	TracerJaeger = "jaeger"
+	// TracerOTel creates span for each proxied call and exports spans over OTLP/HTTP
+	TracerOTel = "otel"
+)
+
+/*
+TraceConfig is config under 'ioc-golang.aop.trace', OpenTelemetry tracer can be enabled like:
+
+	ioc-golang:
+	  aop:
+	    trace:
+	      tracer: otel
+	      otel:
+	        endpoint: localhost:4318
+	        insecure: true
+	        sampler: parentbased_traceidratio
+	        sampler-arg: 0.1
+*/
 type TraceConfig struct {
 	CollectorAddress string `yaml:"collector-address"`
 	ValueDepth       int    `yaml:"value-depth"`
+	// Tracer is 'jaeger' or 'otel', default is 'jaeger'
+	Tracer string     `yaml:"tracer"`
+	OTel   OTelConfig `yaml:"otel"`
+}
+
+// OTelConfig is config of OpenTelemetry tracer, which is used if tracer is 'otel'
+type OTelConfig struct {
+	// Endpoint is host and port of OTLP/HTTP collector, default localhost:4318
+	Endpoint string `yaml:"endpoint"`
+	// URLPath is path of traces api of collector, default /v1/traces
+	URLPath string `yaml:"url-path"`
+	// Insecure uses http instead of https
+	Insecure bool              `yaml:"insecure"`
+	Headers  map[string]string `yaml:"headers"`
+	// Sampler is one of always_on, always_off, traceidratio, parentbased_always_on, parentbased_always_off and
+	// parentbased_traceidratio, default parentbased_always_on
+	Sampler string `yaml:"sampler"`
+	// SamplerArg is ratio of traceidratio samplers, in range [0, 1]
+	SamplerArg float64 `yaml:"sampler-arg"`
 }
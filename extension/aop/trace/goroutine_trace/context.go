@@ -18,8 +18,6 @@ package goroutine_trace
 import (
 	"fmt"
 
-	"github.com/petermattis/goid"
-
 	"github.com/alibaba/ioc-golang/aop"
 )
 
@@ -32,7 +30,6 @@ import (
 type GoRoutineTracingContext struct {
 	entranceMethodFullName string
 	facadeCtx              GoRoutineTracingFacadeContext
-	grID                   int64
 }
 
 type GoRoutineTracingContextParams struct {
@@ -50,7 +47,6 @@ func (p *GoRoutineTracingContextParams) initGoRoutineTracingContext(c *GoRoutine
 	}
 	c.entranceMethodFullName = p.EntranceMethodFullName
 	c.facadeCtx = p.FacadeCtx
-	c.grID = goid.Get()
 	return c, nil
 }
 
@@ -16,12 +16,7 @@
 package goroutine_trace
 
 import (
-	"sync"
-
-	"github.com/petermattis/goid"
-
 	"github.com/alibaba/ioc-golang/aop"
-	"github.com/alibaba/ioc-golang/aop/common"
 )
 
 // +ioc:autowire=true
@@ -29,59 +24,45 @@ import (
 // +ioc:autowire:proxy:autoInjection=false
 
 type GoRoutineTraceInterceptor struct {
-	tracingGrIDMap sync.Map // tracingGrIDMap stores goroutine-id -> goRoutineTracingContext
+}
+
+// tracingContextKey is key of GoRoutineTracingContext set to invocation context of tracing entrance, each facade
+// ctx type is traced separately
+type tracingContextKey struct {
+	facadeCtxType string
 }
 
 func (g *GoRoutineTraceInterceptor) BeforeInvoke(ctx *aop.InvocationContext, facadeCtxType string) {
-	// if current goroutine is in tracing
-	if traceCtxVal, ok := g.tracingGrIDMap.Load(ctx.GrID); ok {
-		traceCtx := traceCtxVal.(*GoRoutineTracingContext)
-		if traceCtx.GetFacadeCtx().Type() != facadeCtxType {
-			return
-		}
-		traceCtxVal.(*GoRoutineTracingContext).facadeCtx.BeforeInvoke(ctx)
+	// if current invocation is in tracing
+	if traceCtx := g.GetTracingContext(ctx, facadeCtxType); traceCtx != nil {
+		traceCtx.facadeCtx.BeforeInvoke(ctx)
 	}
 }
 
 func (g *GoRoutineTraceInterceptor) AfterInvoke(ctx *aop.InvocationContext, facadeCtxType string) {
-	// if current goroutine is watched?
-	if traceCtxVal, ok := g.tracingGrIDMap.Load(ctx.GrID); ok {
-		// this goRoutine is in tracing, return span
-		traceCtx := traceCtxVal.(*GoRoutineTracingContext)
-		if traceCtx.GetFacadeCtx().Type() != facadeCtxType {
-			return
-		}
+	// if current invocation is in tracing, tracing finishes with invocation of entrance, which holds the tracing context
+	if traceCtx := g.GetTracingContext(ctx, facadeCtxType); traceCtx != nil {
+		// todo send events to facade ctx if necessary
 		traceCtx.facadeCtx.AfterInvoke(ctx)
-		// calculate level
-		if common.IsTraceEntrance(traceCtx.entranceMethodFullName) {
-			// tracing finished, auto delete tracing
-			// todo send events to facade ctx if necessary
-			g.tracingGrIDMap.Delete(ctx.GrID)
-		}
 	}
 }
 
-func (g *GoRoutineTraceInterceptor) AddCurrentGRTracingContext(ctx *GoRoutineTracingContext) {
-	g.tracingGrIDMap.Store(ctx.grID, ctx)
-}
-
-func (g *GoRoutineTraceInterceptor) DeleteCurrentGRTracingContext() {
-	grID := goid.Get()
-	g.tracingGrIDMap.Delete(grID)
+// AddTracingContext starts tracing from invocation, invocations called by it are traced, even if they run on other
+// goroutines with context.Context or aop.Go
+func (g *GoRoutineTraceInterceptor) AddTracingContext(invocationCtx *aop.InvocationContext, ctx *GoRoutineTracingContext) {
+	invocationCtx.SetValue(tracingContextKey{facadeCtxType: ctx.GetFacadeCtx().Type()}, ctx)
 }
 
-// GetCurrentGRTracingContext return expected ctx type tracing ctx, FIXME: we now only support one facade tracing ctx type now
-// e.g. if log debug gr is watching, another gr watching like 'trace' watching is invalid
-func (g *GoRoutineTraceInterceptor) GetCurrentGRTracingContext(ctxType string) *GoRoutineTracingContext {
-	grID := goid.Get()
-	val, ok := g.tracingGrIDMap.Load(grID)
-	if !ok {
+// GetTracingContext returns tracing ctx of expected type that invocation is in, nil if not in tracing
+func (g *GoRoutineTraceInterceptor) GetTracingContext(invocationCtx *aop.InvocationContext, ctxType string) *GoRoutineTracingContext {
+	val, _ := invocationCtx.Value(tracingContextKey{facadeCtxType: ctxType})
+	if val == nil {
 		return nil
 	}
-	grTracingCtx := val.(*GoRoutineTracingContext)
+	return val.(*GoRoutineTracingContext)
+}
 
-	if grTracingCtx.GetFacadeCtx().Type() != ctxType {
-		return nil
-	}
-	return grTracingCtx
+// GetCurrentGRTracingContext return expected ctx type tracing ctx of the invocation running on current goroutine
+func (g *GoRoutineTraceInterceptor) GetCurrentGRTracingContext(ctxType string) *GoRoutineTracingContext {
+	return g.GetTracingContext(aop.GetCurrentInvocationCtx(), ctxType)
 }
@@ -56,11 +56,11 @@ type goRoutineTracingContextParamsInterface interface {
 	initGoRoutineTracingContext(impl *GoRoutineTracingContext) (*GoRoutineTracingContext, error)
 }
 type goRoutineTraceInterceptor_ struct {
-	BeforeInvoke_                  func(ctx *aop.InvocationContext, facadeCtxType string)
-	AfterInvoke_                   func(ctx *aop.InvocationContext, facadeCtxType string)
-	AddCurrentGRTracingContext_    func(ctx *GoRoutineTracingContext)
-	DeleteCurrentGRTracingContext_ func()
-	GetCurrentGRTracingContext_    func(ctxType string) *GoRoutineTracingContext
+	BeforeInvoke_               func(ctx *aop.InvocationContext, facadeCtxType string)
+	AfterInvoke_                func(ctx *aop.InvocationContext, facadeCtxType string)
+	AddTracingContext_          func(invocationCtx *aop.InvocationContext, ctx *GoRoutineTracingContext)
+	GetTracingContext_          func(invocationCtx *aop.InvocationContext, ctxType string) *GoRoutineTracingContext
+	GetCurrentGRTracingContext_ func(ctxType string) *GoRoutineTracingContext
 }
 
 func (g *goRoutineTraceInterceptor_) BeforeInvoke(ctx *aop.InvocationContext, facadeCtxType string) {
@@ -71,12 +71,12 @@ func (g *goRoutineTraceInterceptor_) AfterInvoke(ctx *aop.InvocationContext, fac
 	g.AfterInvoke_(ctx, facadeCtxType)
 }
 
-func (g *goRoutineTraceInterceptor_) AddCurrentGRTracingContext(ctx *GoRoutineTracingContext) {
-	g.AddCurrentGRTracingContext_(ctx)
+func (g *goRoutineTraceInterceptor_) AddTracingContext(invocationCtx *aop.InvocationContext, ctx *GoRoutineTracingContext) {
+	g.AddTracingContext_(invocationCtx, ctx)
 }
 
-func (g *goRoutineTraceInterceptor_) DeleteCurrentGRTracingContext() {
-	g.DeleteCurrentGRTracingContext_()
+func (g *goRoutineTraceInterceptor_) GetTracingContext(invocationCtx *aop.InvocationContext, ctxType string) *GoRoutineTracingContext {
+	return g.GetTracingContext_(invocationCtx, ctxType)
 }
 
 func (g *goRoutineTraceInterceptor_) GetCurrentGRTracingContext(ctxType string) *GoRoutineTracingContext {
@@ -86,8 +86,8 @@ func (g *goRoutineTraceInterceptor_) GetCurrentGRTracingContext(ctxType string)
 type GoRoutineTraceInterceptorIOCInterface interface {
 	BeforeInvoke(ctx *aop.InvocationContext, facadeCtxType string)
 	AfterInvoke(ctx *aop.InvocationContext, facadeCtxType string)
-	AddCurrentGRTracingContext(ctx *GoRoutineTracingContext)
-	DeleteCurrentGRTracingContext()
+	AddTracingContext(invocationCtx *aop.InvocationContext, ctx *GoRoutineTracingContext)
+	GetTracingContext(invocationCtx *aop.InvocationContext, ctxType string) *GoRoutineTracingContext
 	GetCurrentGRTracingContext(ctxType string) *GoRoutineTracingContext
 }
 
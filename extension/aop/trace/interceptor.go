@@ -20,6 +20,7 @@ import (
 	"log"
 
 	opentracing "github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
 	opentracingLog "github.com/opentracing/opentracing-go/log"
 
 	"github.com/alibaba/ioc-golang/aop"
@@ -38,8 +39,12 @@ type traceInterceptor struct {
 }
 
 func (m *traceInterceptor) BeforeInvoke(ctx *aop.InvocationContext) {
+	if otelTracerImpl != nil {
+		otelTracerImpl.beforeInvoke(ctx)
+	}
+
 	// 1. find if already in goroutine tracing
-	if m.GoRoutineInterceptor.GetCurrentGRTracingContext(traceGoRoutineInterceptorFacadeCtxType) != nil {
+	if m.GoRoutineInterceptor.GetTracingContext(ctx, traceGoRoutineInterceptorFacadeCtxType) != nil {
 		m.GoRoutineInterceptor.BeforeInvoke(ctx, traceGoRoutineInterceptorFacadeCtxType)
 		return
 	}
@@ -83,12 +88,15 @@ func (m *traceInterceptor) BeforeInvoke(ctx *aop.InvocationContext) {
 	})
 
 	// start tracing
-	m.GoRoutineInterceptor.AddCurrentGRTracingContext(grCtx)
+	m.GoRoutineInterceptor.AddTracingContext(ctx, grCtx)
 	m.GoRoutineInterceptor.BeforeInvoke(ctx, traceGoRoutineInterceptorFacadeCtxType)
 }
 
 func (m *traceInterceptor) AfterInvoke(ctx *aop.InvocationContext) {
 	m.GoRoutineInterceptor.AfterInvoke(ctx, traceGoRoutineInterceptorFacadeCtxType)
+	if otelTracerImpl != nil {
+		otelTracerImpl.afterInvoke(ctx)
+	}
 }
 
 func (m *traceInterceptor) StartTraceByMethod(traceCtx *debugServerTraceByMethodContext) {
@@ -102,7 +110,7 @@ func (m *traceInterceptor) StopTraceByMethod() {
 func (m *traceInterceptor) GetCurrentSpan() opentracing.Span {
 	if currentGRTracingCtx := m.GoRoutineInterceptor.GetCurrentGRTracingContext(traceGoRoutineInterceptorFacadeCtxType); currentGRTracingCtx != nil {
 		facadeCtx := currentGRTracingCtx.GetFacadeCtx().(*traceGoRoutineInterceptorFacadeCtx)
-		return facadeCtx.trace.currentSpan.span
+		return facadeCtx.trace.getSpan(aop.GetCurrentInvocationCtx()).span
 	}
 	return nil
 }
@@ -147,14 +155,22 @@ func (p *traceGoRoutineInterceptorFacadeCtxParam) newTraceGoRoutineInterceptorFa
 }
 
 func (t *traceGoRoutineInterceptorFacadeCtx) BeforeInvoke(ctx *aop.InvocationContext) {
-	currentSpan := t.trace.addChildSpan(ctx.MethodFullName)
+	currentSpan := t.trace.addChildSpan(ctx)
 	currentSpan.span.LogFields(opentracingLog.String(traceCommon.SpanParamsKey, common.ReflectValues2String(ctx.Params, valueDepth, valueLength)))
 }
 
 func (t *traceGoRoutineInterceptorFacadeCtx) AfterInvoke(ctx *aop.InvocationContext) {
-	currentSpan := t.trace.currentSpan.span
-	t.trace.returnSpan()
-	currentSpan.LogFields(opentracingLog.String(traceCommon.SpanReturnValuesKey, common.ReflectValues2String(ctx.ReturnValues, int(t.maxDepth), int(t.maxLength))))
+	currentSpan := t.trace.returnSpan(ctx)
+	if currentSpan == nil {
+		// invocation started before tracing
+		return
+	}
+	currentSpan.span.LogFields(opentracingLog.String(traceCommon.SpanReturnValuesKey, common.ReflectValues2String(ctx.ReturnValues, int(t.maxDepth), int(t.maxLength))))
+	if ctx.Panic != nil {
+		ext.Error.Set(currentSpan.span, true)
+		currentSpan.span.LogFields(opentracingLog.String(traceCommon.SpanPanicKey, fmt.Sprintf("%+v", ctx.Panic)))
+	}
+	currentSpan.span.Finish()
 }
 func (t *traceGoRoutineInterceptorFacadeCtx) Type() string {
 	return traceGoRoutineInterceptorFacadeCtxType
@@ -0,0 +1,213 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trace
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.10.0"
+	oteltrace "go.opentelemetry.io/otel/trace"
+
+	"github.com/alibaba/ioc-golang/aop"
+	"github.com/alibaba/ioc-golang/aop/common"
+	traceCommon "github.com/alibaba/ioc-golang/extension/aop/trace/common"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+const (
+	otelInstrumentationName = "github.com/alibaba/ioc-golang/extension/aop/trace"
+
+	otelSamplerAlwaysOn                = "always_on"
+	otelSamplerAlwaysOff               = "always_off"
+	otelSamplerTraceIDRatio            = "traceidratio"
+	otelSamplerParentBasedAlwaysOn     = "parentbased_always_on"
+	otelSamplerParentBasedAlwaysOff    = "parentbased_always_off"
+	otelSamplerParentBasedTraceIDRatio = "parentbased_traceidratio"
+
+	otelAttributeSDID   = "ioc_golang.sdid"
+	otelAttributeMethod = "ioc_golang.method"
+
+	otelShutdownTimeout = time.Second * 5
+)
+
+// otelTracerImpl is set if tracer is 'otel', which creates span for each proxied call
+var otelTracerImpl *otelTracer
+
+// otelTracer creates spans of proxied calls, and propagates span context in rpc headers with W3C traceparent
+type otelTracer struct {
+	provider   *sdktrace.TracerProvider
+	tracer     oteltrace.Tracer
+	propagator propagation.TextMapPropagator
+
+	// spans stores invocation-id -> span of invocation
+	spans sync.Map
+}
+
+func newOTelTracer(service string, otelConfig OTelConfig) (*otelTracer, error) {
+	sampler, err := newOTelSampler(otelConfig.Sampler, otelConfig.SamplerArg)
+	if err != nil {
+		return nil, err
+	}
+	options := make([]otlptracehttp.Option, 0)
+	if otelConfig.Endpoint != "" {
+		options = append(options, otlptracehttp.WithEndpoint(otelConfig.Endpoint))
+	}
+	if otelConfig.URLPath != "" {
+		options = append(options, otlptracehttp.WithURLPath(otelConfig.URLPath))
+	}
+	if otelConfig.Insecure {
+		options = append(options, otlptracehttp.WithInsecure())
+	}
+	if len(otelConfig.Headers) > 0 {
+		options = append(options, otlptracehttp.WithHeaders(otelConfig.Headers))
+	}
+	exporter, err := otlptracehttp.New(context.Background(), options...)
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sampler),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(service))),
+	)
+	return &otelTracer{
+		provider:   provider,
+		tracer:     provider.Tracer(otelInstrumentationName),
+		propagator: propagation.TraceContext{},
+	}, nil
+}
+
+func newOTelSampler(name string, arg float64) (sdktrace.Sampler, error) {
+	if (name == otelSamplerTraceIDRatio || name == otelSamplerParentBasedTraceIDRatio) && (arg < 0 || arg > 1) {
+		return nil, fmt.Errorf("invalid sampler arg %f of sampler %s, which should be in range [0, 1]", arg, name)
+	}
+	switch name {
+	case otelSamplerAlwaysOn:
+		return sdktrace.AlwaysSample(), nil
+	case otelSamplerAlwaysOff:
+		return sdktrace.NeverSample(), nil
+	case otelSamplerTraceIDRatio:
+		return sdktrace.TraceIDRatioBased(arg), nil
+	case otelSamplerParentBasedAlwaysOn, "":
+		return sdktrace.ParentBased(sdktrace.AlwaysSample()), nil
+	case otelSamplerParentBasedAlwaysOff:
+		return sdktrace.ParentBased(sdktrace.NeverSample()), nil
+	case otelSamplerParentBasedTraceIDRatio:
+		return sdktrace.ParentBased(sdktrace.TraceIDRatioBased(arg)), nil
+	}
+	return nil, fmt.Errorf("unknown sampler %s", name)
+}
+
+/*
+beforeInvoke starts span of invocation, whose parent is span of the nearest traced invocation in the chain, or span
+carried by context of the nearest invocation in the chain, like context.Context param, or context of rpc request
+carrying span context extracted from header. The span is passed to raw method by context.Context param if any.
+*/
+func (o *otelTracer) beforeInvoke(ctx *aop.InvocationContext) {
+	parentCtx := o.getParentContext(ctx)
+	_, span := o.tracer.Start(parentCtx, ctx.MethodFullName, oteltrace.WithAttributes(
+		attribute.String(otelAttributeSDID, ctx.SDID),
+		attribute.String(otelAttributeMethod, ctx.MethodName),
+	))
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String(traceCommon.SpanParamsKey,
+			common.ReflectValues2String(ctx.Params, valueDepth, valueLength)))
+	}
+	o.spans.Store(ctx.ID, span)
+	if ctx.Context != nil && len(ctx.Params) > 0 {
+		ctx.Context = oteltrace.ContextWithSpan(ctx.Context, span)
+		ctx.Params[0] = reflect.ValueOf(&ctx.Context).Elem()
+	}
+}
+
+func (o *otelTracer) afterInvoke(ctx *aop.InvocationContext) {
+	val, ok := o.spans.LoadAndDelete(ctx.ID)
+	if !ok {
+		return
+	}
+	span := val.(oteltrace.Span)
+	if span.IsRecording() {
+		span.SetAttributes(attribute.String(traceCommon.SpanReturnValuesKey,
+			common.ReflectValues2String(ctx.ReturnValues, valueDepth, valueLength)))
+		if ctx.Panic != nil {
+			span.SetAttributes(attribute.String(traceCommon.SpanPanicKey, fmt.Sprintf("%+v", ctx.Panic)))
+			span.RecordError(ctx.Panic)
+			span.SetStatus(codes.Error, ctx.Panic.Error())
+		} else if failed, err := common.IsInvocationFailed(ctx.ReturnValues); failed {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	span.End()
+}
+
+func (o *otelTracer) getParentContext(ctx *aop.InvocationContext) context.Context {
+	if span := o.getSpan(ctx.Parent); span != nil {
+		return oteltrace.ContextWithSpan(context.Background(), span)
+	}
+	for c := ctx; c != nil; c = c.Parent {
+		if c.Context != nil && oteltrace.SpanContextFromContext(c.Context).IsValid() {
+			return c.Context
+		}
+	}
+	return context.Background()
+}
+
+// getSpan returns span of the nearest invocation in the chain of ctx, nil if not found
+func (o *otelTracer) getSpan(ctx *aop.InvocationContext) oteltrace.Span {
+	for ; ctx != nil; ctx = ctx.Parent {
+		if span, ok := o.spans.Load(ctx.ID); ok {
+			return span.(oteltrace.Span)
+		}
+	}
+	return nil
+}
+
+// inject writes traceparent of invocation ctx to rpc request header
+func (o *otelTracer) inject(ctx *aop.InvocationContext, header http.Header) {
+	if span := o.getSpan(ctx); span != nil {
+		o.propagator.Inject(oteltrace.ContextWithSpan(context.Background(), span), propagation.HeaderCarrier(header))
+	}
+}
+
+// extract reads traceparent from rpc request header, and returns context carrying it, nil is returned if not found
+func (o *otelTracer) extract(header http.Header) context.Context {
+	remoteCtx := o.propagator.Extract(context.Background(), propagation.HeaderCarrier(header))
+	if !oteltrace.SpanContextFromContext(remoteCtx).IsValid() {
+		return nil
+	}
+	return remoteCtx
+}
+
+// shutdown exports spans that are not exported yet and stops the provider
+func (o *otelTracer) shutdown() {
+	ctx, cancel := context.WithTimeout(context.Background(), otelShutdownTimeout)
+	defer cancel()
+	if err := o.provider.Shutdown(ctx); err != nil {
+		logger.Red("[AOP trace] Shutdown OpenTelemetry tracer failed, error = %s", err)
+	}
+}
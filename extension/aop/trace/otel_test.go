@@ -0,0 +1,178 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package trace
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	tracepb "go.opentelemetry.io/proto/otlp/trace/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/alibaba/ioc-golang/aop"
+)
+
+// collectorStub is in-process OTLP/HTTP collector that keeps received spans
+type collectorStub struct {
+	lock  sync.Mutex
+	spans []*tracepb.Span
+}
+
+func (c *collectorStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil || r.URL.Path != "/v1/traces" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	req := &coltracepb.ExportTraceServiceRequest{}
+	if err := proto.Unmarshal(body, req); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	c.lock.Lock()
+	for _, resourceSpans := range req.GetResourceSpans() {
+		for _, scopeSpans := range resourceSpans.GetScopeSpans() {
+			c.spans = append(c.spans, scopeSpans.GetSpans()...)
+		}
+	}
+	c.lock.Unlock()
+	rsp, _ := proto.Marshal(&coltracepb.ExportTraceServiceResponse{})
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	_, _ = w.Write(rsp)
+}
+
+func (c *collectorStub) getSpans() map[string]*tracepb.Span {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	spans := make(map[string]*tracepb.Span)
+	for _, span := range c.spans {
+		spans[span.GetName()] = span
+	}
+	return spans
+}
+
+func newTestOTelTracer(t *testing.T, sampler string) (*otelTracer, *collectorStub) {
+	collector := &collectorStub{}
+	server := httptest.NewServer(collector)
+	t.Cleanup(server.Close)
+	otelTracer, err := newOTelTracer("test-app", OTelConfig{
+		Endpoint: strings.TrimPrefix(server.URL, "http://"),
+		Insecure: true,
+		Sampler:  sampler,
+	})
+	assert.Nil(t, err)
+	return otelTracer, collector
+}
+
+func newTestInvocationContext(methodName string, parent *aop.InvocationContext, err error) *aop.InvocationContext {
+	ctx := &aop.InvocationContext{
+		ID:             uuid.New(),
+		SDID:           "github.com/alibaba/ioc-golang/extension/aop/trace.testService",
+		MethodName:     methodName,
+		MethodFullName: "testService." + methodName,
+		MethodType:     reflect.TypeOf(func() error { return nil }),
+		Parent:         parent,
+	}
+	ctx.ReturnValues = ctx.ReturnValuesWithError(err)
+	return ctx
+}
+
+func TestOTelTracer(t *testing.T) {
+	otelTracer, collector := newTestOTelTracer(t, otelSamplerAlwaysOn)
+
+	parentCtx := newTestInvocationContext("Parent", nil, nil)
+	childCtx := newTestInvocationContext("Child", parentCtx, errors.New("child failed"))
+	otelTracer.beforeInvoke(parentCtx)
+	otelTracer.beforeInvoke(childCtx)
+	otelTracer.afterInvoke(childCtx)
+	otelTracer.afterInvoke(parentCtx)
+	assert.Nil(t, otelTracer.provider.ForceFlush(context.Background()))
+
+	spans := collector.getSpans()
+	assert.Equal(t, 2, len(spans))
+	parentSpan := spans["testService.Parent"]
+	childSpan := spans["testService.Child"]
+	assert.NotNil(t, parentSpan)
+	assert.NotNil(t, childSpan)
+	assert.Equal(t, parentSpan.GetTraceId(), childSpan.GetTraceId())
+	assert.Equal(t, parentSpan.GetSpanId(), childSpan.GetParentSpanId())
+	assert.Equal(t, tracepb.Status_STATUS_CODE_ERROR, childSpan.GetStatus().GetCode())
+	assert.Equal(t, "child failed", childSpan.GetStatus().GetMessage())
+}
+
+func TestOTelTracerPropagation(t *testing.T) {
+	otelTracer, collector := newTestOTelTracer(t, otelSamplerParentBasedAlwaysOn)
+
+	// client injects traceparent of current invocation
+	clientCtx := newTestInvocationContext("Client", nil, nil)
+	otelTracer.beforeInvoke(clientCtx)
+	header := http.Header{}
+	otelTracer.inject(clientCtx, header)
+	assert.NotEmpty(t, header.Get("traceparent"))
+
+	// server carries extracted traceparent by context of request invocation, which is parent of invocations of request
+	assert.Nil(t, otelTracer.extract(http.Header{}))
+	requestCtx := &aop.InvocationContext{
+		ID:      uuid.New(),
+		Context: otelTracer.extract(header),
+	}
+	assert.NotNil(t, requestCtx.Context)
+	serverCtx := newTestInvocationContext("Server", requestCtx, nil)
+	otelTracer.beforeInvoke(serverCtx)
+	otelTracer.afterInvoke(serverCtx)
+	otelTracer.afterInvoke(clientCtx)
+	assert.Nil(t, otelTracer.provider.ForceFlush(context.Background()))
+
+	spans := collector.getSpans()
+	assert.Equal(t, spans["testService.Client"].GetTraceId(), spans["testService.Server"].GetTraceId())
+	assert.Equal(t, spans["testService.Client"].GetSpanId(), spans["testService.Server"].GetParentSpanId())
+}
+
+func TestOTelTracerShutdown(t *testing.T) {
+	otelTracer, collector := newTestOTelTracer(t, otelSamplerAlwaysOn)
+	ctx := newTestInvocationContext("Stopped", nil, nil)
+	otelTracer.beforeInvoke(ctx)
+	otelTracer.afterInvoke(ctx)
+	assert.Equal(t, 0, len(collector.getSpans()))
+
+	// spans batched in memory are exported when app stops
+	otelTracer.shutdown()
+	assert.NotNil(t, collector.getSpans()["testService.Stopped"])
+}
+
+func TestOTelTracerSampler(t *testing.T) {
+	otelTracer, collector := newTestOTelTracer(t, otelSamplerAlwaysOff)
+	ctx := newTestInvocationContext("NotSampled", nil, nil)
+	otelTracer.beforeInvoke(ctx)
+	otelTracer.afterInvoke(ctx)
+	assert.Nil(t, otelTracer.provider.ForceFlush(context.Background()))
+	assert.Equal(t, 0, len(collector.getSpans()))
+
+	_, err := newOTelSampler("unknown", 0)
+	assert.NotNil(t, err)
+	_, err = newOTelSampler(otelSamplerTraceIDRatio, 2)
+	assert.NotNil(t, err)
+}
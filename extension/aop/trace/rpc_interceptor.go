@@ -23,26 +23,37 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/opentracing/opentracing-go"
-	"github.com/petermattis/goid"
 
+	"github.com/alibaba/ioc-golang/aop"
 	"github.com/alibaba/ioc-golang/extension/aop/trace/goroutine_trace"
 )
 
 // +ioc:autowire=true
 // +ioc:autowire:type=singleton
-// +ioc:autowire:constructFunc=initRPCInterceptor
 
 type rpcInterceptor struct {
-	tracingGRIDMap   map[int64]struct{}
 	TraceInterceptor goroutine_trace.GoRoutineTraceInterceptorIOCInterface `singleton:""`
 }
 
-func initRPCInterceptor(r *rpcInterceptor) (*rpcInterceptor, error) {
-	r.tracingGRIDMap = make(map[int64]struct{})
-	return r, nil
-}
+// restoreInvocationCtxKey is key of gin context, whose value restores invocation context of goroutine after rpc request
+const restoreInvocationCtxKey = "ioc-golang-trace-restore-invocation-ctx"
 
 func (r *rpcInterceptor) BeforeServerInvoke(c *gin.Context) error {
+	// invocations of rpc request are called on current goroutine without context param, so tracing state of the request
+	// is carried by an invocation context bound to current goroutine, which is restored after rpc request finishes
+	var serverInvocationCtx *aop.InvocationContext
+	getServerInvocationCtx := func() *aop.InvocationContext {
+		if serverInvocationCtx == nil {
+			serverInvocationCtx = aop.NewInvocationContext(nil, "", c.Request.URL.Path, c.Request.URL.Path, nil)
+			c.Set(restoreInvocationCtxKey, aop.BindCurrentInvocationCtx(serverInvocationCtx))
+		}
+		return serverInvocationCtx
+	}
+	if otelTracerImpl != nil {
+		if remoteCtx := otelTracerImpl.extract(c.Request.Header); remoteCtx != nil {
+			getServerInvocationCtx().Context = remoteCtx
+		}
+	}
 	carrier := opentracing.HTTPHeadersCarrier(c.Request.Header)
 	clientContext, err := getGlobalTracer().getRawTracer().Extract(opentracing.HTTPHeaders, carrier)
 	if err == nil {
@@ -64,37 +75,29 @@ func (r *rpcInterceptor) BeforeServerInvoke(c *gin.Context) error {
 		}
 
 		traceByGrContext, err := goroutine_trace.GetGoRoutineTracingContext(&goroutine_trace.GoRoutineTracingContextParams{
-			/**
-			FIXME: now we just put short method name as full name, this would make TraceInterceptor never meet
-			'current span' and never jump out of tracing, so we call r.TraceInterceptor.DeleteCurrentGRTracingContext()
-			in AfterServerInvoke to force stop the tracing, but that's not graceful
-
-			Now, EntranceMethodFullName can be any string except empty, we just want 'current span' never match in TraceInterceptor
-			*/
 			EntranceMethodFullName: method,
 			FacadeCtx:              facadeCtx,
 		})
 		if err != nil {
 			return err
 		}
-		r.TraceInterceptor.AddCurrentGRTracingContext(traceByGrContext)
-		r.tracingGRIDMap[goid.Get()] = struct{}{}
+		r.TraceInterceptor.AddTracingContext(getServerInvocationCtx(), traceByGrContext)
 	}
 	return nil
 }
 
 func (r *rpcInterceptor) AfterServerInvoke(ctx *gin.Context) error {
-	grID := goid.Get()
-	if _, ok := r.tracingGRIDMap[grID]; ok {
-		// force stop tracing as the rpc is finished
-		// FIXME: gr tracing type is ignored, this would cause existing debug log tracing failed.
-		r.TraceInterceptor.DeleteCurrentGRTracingContext()
-		delete(r.tracingGRIDMap, grID)
+	if restore, ok := ctx.Get(restoreInvocationCtxKey); ok {
+		// stop tracing as the rpc is finished
+		restore.(func())()
 	}
 	return nil
 }
 
 func (r *rpcInterceptor) BeforeClientInvoke(req *http.Request) error {
+	if otelTracerImpl != nil {
+		otelTracerImpl.inject(aop.GetCurrentInvocationCtx(), req.Header)
+	}
 	// inject tracing context if necessary
 	if currentGRTracingCtx := r.TraceInterceptor.GetCurrentGRTracingContext(traceGoRoutineInterceptorFacadeCtxType); currentGRTracingCtx != nil {
 		if currentSpan := currentGRTracingCtx.GetFacadeCtx(); currentSpan != nil {
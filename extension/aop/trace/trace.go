@@ -16,25 +16,26 @@
 package trace
 
 import (
+	"sync"
 	"time"
 
-	"github.com/petermattis/goid"
-
 	"github.com/opentracing/opentracing-go"
 	"github.com/opentracing/opentracing-go/ext"
+
+	"github.com/alibaba/ioc-golang/aop"
 )
 
 type trace struct {
-	grID           int64
-	currentSpan    *spanWithParent
+	rootSpan *spanWithParent
+	// spans stores invocation-id -> span of invocation, invocations of one trace may run on different goroutines
+	spans          sync.Map
 	entranceMethod string
 }
 
 func newTraceWithClientSpanContext(entranceMethod string, clientSpanContext opentracing.SpanContext) *trace {
 	rootSpan := getGlobalTracer().getRawTracer().StartSpan(entranceMethod, ext.RPCServerOption(clientSpanContext))
 	return &trace{
-		grID:           goid.Get(),
-		currentSpan:    newSpanWithParent(rootSpan, nil),
+		rootSpan:       newSpanWithParent(rootSpan, nil),
 		entranceMethod: entranceMethod,
 	}
 }
@@ -42,20 +43,34 @@ func newTraceWithClientSpanContext(entranceMethod string, clientSpanContext open
 func newTrace(entranceMethod string) *trace {
 	rootSpan := getGlobalTracer().getRawTracer().StartSpan(entranceMethod)
 	return &trace{
-		grID:           goid.Get(),
-		currentSpan:    newSpanWithParent(rootSpan, nil),
+		rootSpan:       newSpanWithParent(rootSpan, nil),
 		entranceMethod: entranceMethod,
 	}
 }
 
-func (t *trace) addChildSpan(name string) *spanWithParent {
-	func1Span := getGlobalTracer().getRawTracer().StartSpan(name, opentracing.ChildOf(t.currentSpan.span.Context()), opentracing.StartTime(time.Now()))
-	innerChildSpan := newSpanWithParent(func1Span, t.currentSpan)
-	t.currentSpan = innerChildSpan
-	return t.currentSpan
+// getSpan returns span of the nearest invocation in the chain of ctx, root span is returned if not found
+func (t *trace) getSpan(ctx *aop.InvocationContext) *spanWithParent {
+	for ; ctx != nil; ctx = ctx.Parent {
+		if span, ok := t.spans.Load(ctx.ID); ok {
+			return span.(*spanWithParent)
+		}
+	}
+	return t.rootSpan
+}
+
+func (t *trace) addChildSpan(ctx *aop.InvocationContext) *spanWithParent {
+	parentSpan := t.getSpan(ctx.Parent)
+	func1Span := getGlobalTracer().getRawTracer().StartSpan(ctx.MethodFullName, opentracing.ChildOf(parentSpan.span.Context()), opentracing.StartTime(time.Now()))
+	innerChildSpan := newSpanWithParent(func1Span, parentSpan)
+	t.spans.Store(ctx.ID, innerChildSpan)
+	return innerChildSpan
 }
 
-func (t *trace) returnSpan() {
-	t.currentSpan.span.Finish()
-	t.currentSpan = t.currentSpan.parentSpan
+// returnSpan removes span of ctx, which should be finished by caller, nil is returned if not found
+func (t *trace) returnSpan(ctx *aop.InvocationContext) *spanWithParent {
+	span, ok := t.spans.LoadAndDelete(ctx.ID)
+	if !ok {
+		return nil
+	}
+	return span.(*spanWithParent)
 }
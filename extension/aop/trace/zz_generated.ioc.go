@@ -89,11 +89,6 @@ func init() {
 		Factory: func() interface{} {
 			return &rpcInterceptor{}
 		},
-		ConstructFunc: func(i interface{}, _ interface{}) (interface{}, error) {
-			impl := i.(*rpcInterceptor)
-			var constructFunc rpcInterceptorConstructFunc = initRPCInterceptor
-			return constructFunc(impl)
-		},
 		Metadata: map[string]interface{}{
 			"aop":      map[string]interface{}{},
 			"autowire": map[string]interface{}{},
@@ -124,7 +119,6 @@ type debugServerTraceByMethodContextParamInterface interface {
 type traceGoRoutineInterceptorFacadeCtxParamInterface interface {
 	newTraceGoRoutineInterceptorFacadeCtx(impl *traceGoRoutineInterceptorFacadeCtx) (*traceGoRoutineInterceptorFacadeCtx, error)
 }
-type rpcInterceptorConstructFunc func(impl *rpcInterceptor) (*rpcInterceptor, error)
 type traceInterceptor_ struct {
 	BeforeInvoke_       func(ctx *aop.InvocationContext)
 	AfterInvoke_        func(ctx *aop.InvocationContext)
@@ -21,7 +21,8 @@ const Name = "transaction"
 
 func init() {
 	aop.RegisterAOP(aop.AOP{
-		Name: Name,
+		Name:  Name,
+		Order: aop.OrderTransaction,
 		InterceptorFactory: func() aop.Interceptor {
 			interfaceImpl, _ := GetinterceptorImplSingleton()
 			return interfaceImpl
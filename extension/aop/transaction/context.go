@@ -17,6 +17,7 @@ package transaction
 
 import (
 	"reflect"
+	"sync"
 
 	"github.com/alibaba/ioc-golang/aop"
 	"github.com/alibaba/ioc-golang/autowire"
@@ -29,8 +30,10 @@ import (
 // +ioc:autowire:paramType=contextParam
 
 type context struct {
-	rollbackAbleInvocationContexts []rollbackAbleInvocationCtxIOCInterface
-	entranceMethodFullName         string
+	// rollbackAbleInvocationContexts may be appended by invocations of the transaction running on different goroutines
+	rollbackAbleInvocationContexts     []rollbackAbleInvocationCtxIOCInterface
+	rollbackAbleInvocationContextsLock sync.Mutex
+	entranceMethodFullName             string
 }
 
 type contextParam struct {
@@ -52,6 +55,8 @@ func (c *context) GetEntranceMethodFullName() string {
 }
 
 func (c *context) Failed(err error) {
+	c.rollbackAbleInvocationContextsLock.Lock()
+	defer c.rollbackAbleInvocationContextsLock.Unlock()
 	for i := len(c.rollbackAbleInvocationContexts) - 1; i >= 0; i-- {
 		snapshot := c.rollbackAbleInvocationContexts[i]
 		snapshot.Rollback(err)
@@ -69,7 +74,9 @@ func (c *context) AddSuccessfullyCalledInvocationCtx(ctx *aop.InvocationContext)
 			invocationCtx:      ctx,
 			rollbackMethodName: rollbackMethodName,
 		})
+		c.rollbackAbleInvocationContextsLock.Lock()
 		c.rollbackAbleInvocationContexts = append(c.rollbackAbleInvocationContexts, newCtx)
+		c.rollbackAbleInvocationContextsLock.Unlock()
 	}
 }
 
@@ -16,8 +16,6 @@
 package transaction
 
 import (
-	"sync"
-
 	"github.com/alibaba/ioc-golang/aop"
 	"github.com/alibaba/ioc-golang/autowire"
 
@@ -29,13 +27,15 @@ import (
 // +ioc:autowire:proxy:autoInjection=false
 
 type interceptorImpl struct {
-	transactionGrIDMap sync.Map // transactionGrIDMap stores goroutine-id -> TxContext
 }
 
+// txContextKey is key of TxContext set to invocation context of transaction entrance
+type txContextKey struct{}
+
 func (t *interceptorImpl) BeforeInvoke(ctx *aop.InvocationContext) {
 	// 1. if current invocation is already in transaction ?
-	if _, ok := t.transactionGrIDMap.Load(ctx.GrID); ok {
-		// this goRoutine is already in transaction
+	if txCtx, _ := ctx.Value(txContextKey{}); txCtx != nil {
+		// current invocation chain is already in transaction
 		return
 	}
 	// not in transaction
@@ -51,26 +51,24 @@ func (t *interceptorImpl) BeforeInvoke(ctx *aop.InvocationContext) {
 		newCtx, _ := GetcontextIOCInterface(&contextParam{
 			entranceMethodFullName: ctx.MethodFullName,
 		})
-		t.transactionGrIDMap.Store(ctx.GrID, newCtx)
+		ctx.SetValue(txContextKey{}, newCtx)
 		return
 	}
 	// not in transaction, don't want to start a transaction
 }
 
 func (t *interceptorImpl) AfterInvoke(ctx *aop.InvocationContext) {
-	// if current goRoutine is in the transaction ?
-	if val, ok := t.transactionGrIDMap.Load(ctx.GrID); ok {
-		// this goRoutine is in the transaction
+	// if current invocation is in the transaction ?
+	if val, entranceCtx := ctx.Value(txContextKey{}); val != nil {
+		// current invocation is in the transaction
 		txCtx := val.(contextIOCInterface)
 
 		// if invocation failed
 		invocationFailed, err := common.IsInvocationFailed(ctx.ReturnValues)
 
 		// if current invocation is the entrance of transaction ?
-		// check if is entrance
-		if common.IsTraceEntrance(txCtx.GetEntranceMethodFullName()) {
+		if entranceCtx == ctx {
 			// current invocation is the entrance of transaction
-			t.transactionGrIDMap.Delete(ctx.GrID)
 			// if the transaction failed ?
 			if invocationFailed {
 				txCtx.Failed(err)
@@ -89,5 +87,5 @@ func (t *interceptorImpl) AfterInvoke(ctx *aop.InvocationContext) {
 		// the invocation failed
 		return
 	}
-	// the goRoutine is not in the transaction
+	// the invocation is not in the transaction
 }
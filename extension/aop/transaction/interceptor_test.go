@@ -22,8 +22,6 @@ import (
 
 	"github.com/stretchr/testify/mock"
 
-	"github.com/alibaba/ioc-golang/aop/common"
-
 	"github.com/stretchr/testify/assert"
 
 	"github.com/alibaba/ioc-golang/aop"
@@ -37,7 +35,6 @@ type bizStruct struct {
 
 const transactionMethodFullName = "github.com/alibaba/ioc-golang/extension/aop/transaction.(*bizStruct).TestMethodTransaction()"
 const transactionMethodName = "TestMethodTransaction"
-const mockGRID = int64(1)
 
 func TestBeforeInvoke(t *testing.T) {
 	// 1. register mock descriptor
@@ -81,19 +78,47 @@ func TestBeforeInvoke(t *testing.T) {
 	}
 	normal.RegisterStructDescriptor(bizStructSD)
 
-	impl.BeforeInvoke(&aop.InvocationContext{
+	entranceCtx := &aop.InvocationContext{
 		MethodFullName: transactionMethodFullName,
 		MethodName:     transactionMethodName,
 		SDID:           util.GetSDIDByStructPtr(&bizStruct{}),
-		GrID:           mockGRID,
-	})
+	}
+	impl.BeforeInvoke(entranceCtx)
 
-	record, ok := impl.transactionGrIDMap.Load(mockGRID)
-	assert.True(t, ok)
+	record, owner := entranceCtx.Value(txContextKey{})
+	assert.Equal(t, entranceCtx, owner)
 	assert.Equal(t, transactionMethodFullName, record.(contextIOCInterface).GetEntranceMethodFullName())
+
+	// sub invocation joins transaction of entrance, even if it runs on other goroutine
+	subCtx := &aop.InvocationContext{
+		MethodFullName: transactionMethodFullName,
+		MethodName:     transactionMethodName,
+		SDID:           util.GetSDIDByStructPtr(&bizStruct{}),
+		Parent:         entranceCtx,
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		impl.BeforeInvoke(subCtx)
+	}()
+	<-done
+	subRecord, owner := subCtx.Value(txContextKey{})
+	assert.Equal(t, entranceCtx, owner)
+	assert.Equal(t, record, subRecord)
 }
 
 func TestAfterInvoke(t *testing.T) {
+	newEntranceCtx := func(txCtx contextIOCInterface, returnValues []reflect.Value) *aop.InvocationContext {
+		entranceCtx := &aop.InvocationContext{
+			MethodFullName: transactionMethodFullName,
+			MethodName:     transactionMethodName,
+			SDID:           util.GetSDIDByStructPtr(&bizStruct{}),
+			ReturnValues:   returnValues,
+		}
+		entranceCtx.SetValue(txContextKey{}, txCtx)
+		return entranceCtx
+	}
+
 	t.Run("transaction success with normal returns", func(t *testing.T) {
 		// 1. create to test object
 		impl, err := GetinterceptorImplSingleton()
@@ -101,24 +126,12 @@ func TestAfterInvoke(t *testing.T) {
 
 		// 2. mock context
 		ctx := newMockContextIOCInterface(t)
-		currentMethodName := common.CurrentCallingMethodName(2)
-		ctx.On("GetEntranceMethodFullName").Return(currentMethodName)
 		ctx.On("Finish").Once()
 
-		impl.transactionGrIDMap.Store(mockGRID, ctx)
-
-		aop.GetMockProxyFunctionLayer()(func() {
-			impl.AfterInvoke(&aop.InvocationContext{
-				MethodFullName: transactionMethodFullName,
-				MethodName:     transactionMethodName,
-				SDID:           util.GetSDIDByStructPtr(&bizStruct{}),
-				GrID:           mockGRID,
-				ReturnValues: []reflect.Value{
-					reflect.ValueOf("value"),
-					reflect.ValueOf("stringValue"),
-				},
-			})
-		})
+		impl.AfterInvoke(newEntranceCtx(ctx, []reflect.Value{
+			reflect.ValueOf("value"),
+			reflect.ValueOf("stringValue"),
+		}))
 	})
 
 	t.Run("transaction success without return values", func(t *testing.T) {
@@ -128,20 +141,9 @@ func TestAfterInvoke(t *testing.T) {
 
 		// 2. mock context
 		ctx := newMockContextIOCInterface(t)
-		currentMethodName := common.CurrentCallingMethodName(2)
-		ctx.On("GetEntranceMethodFullName").Return(currentMethodName)
 		ctx.On("Finish").Once()
 
-		impl.transactionGrIDMap.Store(mockGRID, ctx)
-
-		aop.GetMockProxyFunctionLayer()(func() {
-			impl.AfterInvoke(&aop.InvocationContext{
-				MethodFullName: transactionMethodFullName,
-				MethodName:     transactionMethodName,
-				SDID:           util.GetSDIDByStructPtr(&bizStruct{}),
-				GrID:           mockGRID,
-			})
-		})
+		impl.AfterInvoke(newEntranceCtx(ctx, nil))
 	})
 
 	t.Run("transaction success with nil error returns", func(t *testing.T) {
@@ -151,24 +153,12 @@ func TestAfterInvoke(t *testing.T) {
 
 		// 2. mock context
 		ctx := newMockContextIOCInterface(t)
-		currentMethodName := common.CurrentCallingMethodName(2)
-		ctx.On("GetEntranceMethodFullName").Return(currentMethodName)
 		ctx.On("Finish").Once()
 
-		impl.transactionGrIDMap.Store(mockGRID, ctx)
-
-		aop.GetMockProxyFunctionLayer()(func() {
-			impl.AfterInvoke(&aop.InvocationContext{
-				MethodFullName: transactionMethodFullName,
-				MethodName:     transactionMethodName,
-				SDID:           util.GetSDIDByStructPtr(&bizStruct{}),
-				GrID:           mockGRID,
-				ReturnValues: []reflect.Value{
-					reflect.ValueOf("value"),
-					reflect.ValueOf(new(error)),
-				},
-			})
-		})
+		impl.AfterInvoke(newEntranceCtx(ctx, []reflect.Value{
+			reflect.ValueOf("value"),
+			reflect.ValueOf(new(error)),
+		}))
 	})
 
 	t.Run("transaction failed with entrance method", func(t *testing.T) {
@@ -180,26 +170,14 @@ func TestAfterInvoke(t *testing.T) {
 		expectErr := fmt.Errorf("error")
 
 		ctx := newMockContextIOCInterface(t)
-		currentMethodName := common.CurrentCallingMethodName(2)
-		ctx.On("GetEntranceMethodFullName").Return(currentMethodName)
 		ctx.On("Failed", mock.MatchedBy(func(err error) bool {
 			return err.Error() == expectErr.Error()
 		})).Once()
 
-		impl.transactionGrIDMap.Store(mockGRID, ctx)
-
-		aop.GetMockProxyFunctionLayer()(func() {
-			impl.AfterInvoke(&aop.InvocationContext{
-				MethodFullName: transactionMethodFullName,
-				MethodName:     transactionMethodName,
-				SDID:           util.GetSDIDByStructPtr(&bizStruct{}),
-				GrID:           mockGRID,
-				ReturnValues: []reflect.Value{
-					reflect.ValueOf("value"),
-					reflect.ValueOf(expectErr),
-				},
-			})
-		})
+		impl.AfterInvoke(newEntranceCtx(ctx, []reflect.Value{
+			reflect.ValueOf("value"),
+			reflect.ValueOf(expectErr),
+		}))
 	})
 
 	t.Run("transaction success with not entrance method", func(t *testing.T) {
@@ -209,24 +187,18 @@ func TestAfterInvoke(t *testing.T) {
 
 		// 2. mock context
 		ctx := newMockContextIOCInterface(t)
-		currentMethodName := common.CurrentCallingMethodName(2)
-		ctx.On("GetEntranceMethodFullName").Return(currentMethodName + "notEntrance")
-		ctx.On("AddSuccessfullyCalledInvocationCtx", mock.MatchedBy(func(ctx *aop.InvocationContext) bool {
-			return ctx.GrID == mockGRID
-		})).Once()
-
-		impl.transactionGrIDMap.Store(mockGRID, ctx)
+		entranceCtx := newEntranceCtx(ctx, nil)
+		subCtx := &aop.InvocationContext{
+			MethodFullName: transactionMethodFullName,
+			MethodName:     transactionMethodName,
+			SDID:           util.GetSDIDByStructPtr(&bizStruct{}),
+			Parent:         entranceCtx,
+			ReturnValues: []reflect.Value{
+				reflect.ValueOf("value"),
+			},
+		}
+		ctx.On("AddSuccessfullyCalledInvocationCtx", subCtx).Once()
 
-		aop.GetMockProxyFunctionLayer()(func() {
-			impl.AfterInvoke(&aop.InvocationContext{
-				MethodFullName: transactionMethodFullName,
-				MethodName:     transactionMethodName,
-				SDID:           util.GetSDIDByStructPtr(&bizStruct{}),
-				GrID:           mockGRID,
-				ReturnValues: []reflect.Value{
-					reflect.ValueOf("value"),
-				},
-			})
-		})
+		impl.AfterInvoke(subCtx)
 	})
 }
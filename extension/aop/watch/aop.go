@@ -26,7 +26,8 @@ const Name = "watch"
 
 func init() {
 	aop.RegisterAOP(aop.AOP{
-		Name: Name,
+		Name:  Name,
+		Order: aop.OrderWatch,
 		InterceptorFactory: func() aop.Interceptor {
 			impl, _ := GetinterceptorImplIOCInterfaceSingleton()
 			return impl
@@ -19,8 +19,6 @@ import (
 	"reflect"
 	"sync"
 
-	"github.com/petermattis/goid"
-
 	"github.com/alibaba/ioc-golang/aop"
 	"github.com/alibaba/ioc-golang/aop/common"
 	watchPB "github.com/alibaba/ioc-golang/extension/aop/watch/api/ioc_golang/aop/watch"
@@ -66,7 +64,7 @@ func (w *interceptorImpl) UnWatch(watchCtx contextIOCInterface) {
 // +ioc:autowire:constructFunc=new
 
 type context struct {
-	watchGRRequestMap sync.Map
+	watchGRRequestMap sync.Map // watchGRRequestMap stores invocation-id -> params
 	contextParam
 }
 
@@ -103,12 +101,11 @@ func (c *context) BeforeInvoke(ctx *aop.InvocationContext) {
 		// doesn't match
 		return
 	}
-	grID := goid.Get()
-	c.watchGRRequestMap.Store(grID, ctx.Params)
+	c.watchGRRequestMap.Store(ctx.ID, ctx.Params)
 }
 
 func (c *context) AfterInvoke(ctx *aop.InvocationContext) {
-	paramValues, ok := c.watchGRRequestMap.Load(ctx.GrID)
+	paramValues, ok := c.watchGRRequestMap.LoadAndDelete(ctx.ID)
 	if !ok {
 		return
 	}
@@ -119,5 +116,4 @@ func (c *context) AfterInvoke(ctx *aop.InvocationContext) {
 		ReturnValues: common.ReflectValues2Strings(ctx.ReturnValues, c.MaxDepth, c.MaxLength),
 	}
 	c.Ch <- invokeDetail
-	c.watchGRRequestMap.Delete(ctx.GrID)
 }
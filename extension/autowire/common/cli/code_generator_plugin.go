@@ -28,6 +28,7 @@ import (
 const commonImplementsAnnotation = "ioc:autowire:implements"
 const commonActiveProfileAnnotation = "ioc:autowire:activeProfile"
 const commonLoadAtOnceAnnotation = "ioc:autowire:loadAtOnce"
+const commonRefreshScopeAnnotation = "ioc:autowire:refreshScope"
 
 // +ioc:autowire=true
 // +ioc:autowire:type=allimpls
@@ -39,6 +40,7 @@ type commonCodeGenerationPlugin struct {
 	implements    []string
 	activeProfile string
 	loadAtOnce    bool
+	refreshScope  bool
 	typeName      string
 }
 
@@ -62,11 +64,14 @@ func (t *commonCodeGenerationPlugin) Init(info markers.TypeInfo) {
 		t.implements = append(t.implements, v.(string))
 	}
 
-	activeProfile := ""
-	if activeProfileValues := markers[commonActiveProfileAnnotation]; len(activeProfileValues) > 0 {
-		activeProfile = activeProfileValues[0].(string)
+	// multiple activeProfile markers are joined, implement is active if any of them matches
+	activeProfiles := make([]string, 0)
+	for _, v := range markers[commonActiveProfileAnnotation] {
+		activeProfiles = append(activeProfiles, v.(string))
+	}
+	if len(activeProfiles) > 0 {
+		t.activeProfile = autowire.JoinActiveProfiles(activeProfiles)
 	}
-	t.activeProfile = activeProfile
 
 	loadAtOnce := false
 
@@ -74,10 +79,14 @@ func (t *commonCodeGenerationPlugin) Init(info markers.TypeInfo) {
 		loadAtOnce = loadAtOnceValues[0].(bool)
 	}
 	t.loadAtOnce = loadAtOnce
+
+	if refreshScopeValues := markers[commonRefreshScopeAnnotation]; len(refreshScopeValues) > 0 {
+		t.refreshScope = refreshScopeValues[0].(bool)
+	}
 }
 
 func (t *commonCodeGenerationPlugin) GenerateSDMetadataForOneStruct(root *loader.Package, w plugin.CodeWriter) {
-	if len(t.implements) == 0 && !t.loadAtOnce {
+	if len(t.implements) == 0 && !t.loadAtOnce && !t.refreshScope {
 		return
 	}
 	w.Linef(`"%s": map[string]interface{}{`, autowire.CommonMetadataKey)
@@ -96,7 +105,7 @@ func (t *commonCodeGenerationPlugin) GenerateSDMetadataForOneStruct(root *loader
 		}
 		w.Linef(`},`)
 		if t.activeProfile != "" {
-			w.Linef(`"%s":"%s",`, autowire.CommonActiveProfileMetadataKey, t.activeProfile)
+			w.Linef(`"%s":%q,`, autowire.CommonActiveProfileMetadataKey, t.activeProfile)
 		}
 	}
 
@@ -104,6 +113,11 @@ func (t *commonCodeGenerationPlugin) GenerateSDMetadataForOneStruct(root *loader
 	if t.loadAtOnce {
 		w.Linef(`"%s":%t,`, autowire.CommonLoadAtOnceMetadataKey, true)
 	}
+
+	// generate refresh scope metadata
+	if t.refreshScope {
+		w.Linef(`"%s":%t,`, autowire.CommonRefreshScopeMetadataKey, true)
+	}
 	w.Line(`},`)
 }
 
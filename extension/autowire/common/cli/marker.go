@@ -52,3 +52,15 @@ type iocGolangAutowireLoadAtOnceMarker struct {
 func (m *iocGolangAutowireLoadAtOnceMarker) GetMarkerDefinition() *markers.Definition {
 	return markers.Must(markers.MakeDefinition(commonLoadAtOnceAnnotation, markers.DescribesType, false))
 }
+
+// +ioc:autowire=true
+// +ioc:autowire:type=allimpls
+// +ioc:autowire:proxy=false
+// +ioc:autowire:implements=github.com/alibaba/ioc-golang/iocli/gen/marker.DefinitionGetter
+
+type iocGolangAutowireRefreshScopeMarker struct {
+}
+
+func (m *iocGolangAutowireRefreshScopeMarker) GetMarkerDefinition() *markers.Definition {
+	return markers.Must(markers.MakeDefinition(commonRefreshScopeAnnotation, markers.DescribesType, false))
+}
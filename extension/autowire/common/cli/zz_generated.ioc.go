@@ -101,6 +101,24 @@ func init() {
 	}
 	allimpls.RegisterStructDescriptor(iocGolangAutowireLoadAtOnceMarkerStructDescriptor)
 	var _ marker.DefinitionGetter = &iocGolangAutowireLoadAtOnceMarker{}
+	iocGolangAutowireRefreshScopeMarkerStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &iocGolangAutowireRefreshScopeMarker{}
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(marker.DefinitionGetter),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(iocGolangAutowireRefreshScopeMarkerStructDescriptor)
+	var _ marker.DefinitionGetter = &iocGolangAutowireRefreshScopeMarker{}
 }
 
 type commonCodeGenerationPluginConstructFunc func(impl *commonCodeGenerationPlugin) (*commonCodeGenerationPlugin, error)
@@ -144,3 +162,4 @@ var _commonCodeGenerationPluginSDID string
 var _iocGolangAutowireImplmentsAutoInjectionMarkerSDID string
 var _iocGolangAutowireActiveProfileutoInjectionMarkerSDID string
 var _iocGolangAutowireLoadAtOnceMarkerSDID string
+var _iocGolangAutowireRefreshScopeMarkerSDID string
@@ -23,7 +23,7 @@ import (
 func init() {
 	autowire.RegisterAutowire(func() autowire.Autowire {
 		configAutowire := &Autowire{}
-		configAutowire.Autowire = normal.NewNormalAutowire(nil, &paramLoader{}, configAutowire)
+		configAutowire.Autowire = normal.NewNormalAutowire(&sdidParser{}, &paramLoader{}, configAutowire)
 		return configAutowire
 	}())
 }
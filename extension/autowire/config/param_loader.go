@@ -65,6 +65,9 @@ func (p *paramLoader) Load(sd *autowire.StructDescriptor, fi *autowire.FieldInfo
 		logrus.Errorf("load config path %s error = %s", configTagValue, err.Error())
 		// FIXME ignore config read error?
 	}
+	if err := config.Validate(configTagValue, param); err != nil {
+		return nil, err
+	}
 	return param, nil
 }
 
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"reflect"
+	"sync"
+
+	"github.com/alibaba/ioc-golang/autowire"
+	"github.com/alibaba/ioc-golang/autowire/sdid_parser"
+)
+
+// StructDescriptorProvider is implemented by config types that can't be registered by generated code, like generic
+// type config.ConfigValue[T], whose struct descriptor is registered when the type is injected at the first time
+type StructDescriptorProvider interface {
+	StructDescriptor() *autowire.StructDescriptor
+}
+
+var registerProvidedStructDescriptorLock sync.Mutex
+
+type sdidParser struct {
+}
+
+// Parse parses sdid with default sdid parser, and registers struct descriptor of field type if it's not registered
+// and provided by field type
+func (p *sdidParser) Parse(fi *autowire.FieldInfo) (string, error) {
+	sdID, err := sdid_parser.GetDefaultSDIDParser().Parse(fi)
+	if err != nil {
+		return "", err
+	}
+	registerProvidedStructDescriptorLock.Lock()
+	defer registerProvidedStructDescriptorLock.Unlock()
+	if _, ok := configStructDescriptorMap[sdID]; ok || fi.FieldReflectType == nil || fi.FieldReflectType.Kind() != reflect.Ptr {
+		return sdID, nil
+	}
+	if provider, ok := reflect.New(fi.FieldReflectType.Elem()).Interface().(StructDescriptorProvider); ok {
+		if sd := provider.StructDescriptor(); sd.ID() == sdID {
+			RegisterStructDescriptor(sd)
+		}
+	}
+	return sdID, nil
+}
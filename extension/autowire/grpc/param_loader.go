@@ -51,7 +51,11 @@ func (p *paramLoader) Load(_ *autowire.StructDescriptor, fi *autowire.FieldInfo)
 		return nil, errors.New("not supported")
 	}
 	grpcConfig := &Config{}
-	if err := config.LoadConfigByPrefix(fmt.Sprintf("autowire%[1]sgrpc%[1]s%[2]s", config.YamlConfigSeparator, fi.TagValue), grpcConfig); err != nil {
+	prefix := fmt.Sprintf("autowire%[1]sgrpc%[1]s%[2]s", config.YamlConfigSeparator, fi.TagValue)
+	if err := config.LoadConfigByPrefix(prefix, grpcConfig); err != nil {
+		return nil, err
+	}
+	if err := config.Validate(prefix, grpcConfig); err != nil {
 		return nil, err
 	}
 	return grpc.Dial(grpcConfig.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
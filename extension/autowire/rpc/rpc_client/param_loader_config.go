@@ -54,5 +54,8 @@ func (p *configParamLoader) Load(sd *autowire.StructDescriptor, fi *autowire.Fie
 	if err := config.LoadConfigByPrefix(prefix, param); err != nil {
 		return nil, err
 	}
+	if err := config.Validate(prefix, param); err != nil {
+		return nil, err
+	}
 	return param, nil
 }
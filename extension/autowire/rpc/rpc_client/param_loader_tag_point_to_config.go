@@ -61,5 +61,8 @@ func (p *tagPointToConfigParamLoader) Load(sd *autowire.StructDescriptor, fi *au
 	if err := config.LoadConfigByPrefix(prefix, param); err != nil {
 		return nil, err
 	}
+	if err := config.Validate(prefix, param); err != nil {
+		return nil, err
+	}
 	return param, nil
 }
@@ -0,0 +1,98 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:baseType=true
+// +ioc:autowire:type=config
+// +ioc:autowire:paramType=ConfigByteSize
+// +ioc:autowire:constructFunc=new
+// +ioc:autowire:proxy:autoInjection=false
+
+// ConfigByteSize is loaded from byte size string like '64MiB', '1.5GB', '512k', or integer bytes.
+// KB, MB, GB, TB are multiples of 1000, while K, M, G, T, KiB, MiB, GiB, TiB are multiples of 1024.
+type ConfigByteSize int64
+
+func (ci *ConfigByteSize) Value() int64 {
+	return int64(*ci)
+}
+
+func (ci *ConfigByteSize) new(impl *ConfigByteSize) (*ConfigByteSize, error) {
+	*impl = *ci
+	return impl, nil
+}
+
+func (ci *ConfigByteSize) UnmarshalYAML(node *yaml.Node) error {
+	size, err := ParseByteSize(node.Value)
+	if err != nil {
+		return err
+	}
+	*ci = ConfigByteSize(size)
+	return nil
+}
+
+func FromByteSize(val int64) *ConfigByteSize {
+	configByteSize := ConfigByteSize(val)
+	return &configByteSize
+}
+
+var byteSizeUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"kb":  1e3,
+	"mb":  1e6,
+	"gb":  1e9,
+	"tb":  1e12,
+	"k":   1 << 10,
+	"m":   1 << 20,
+	"g":   1 << 30,
+	"t":   1 << 40,
+	"ki":  1 << 10,
+	"mi":  1 << 20,
+	"gi":  1 << 30,
+	"ti":  1 << 40,
+	"kib": 1 << 10,
+	"mib": 1 << 20,
+	"gib": 1 << 30,
+	"tib": 1 << 40,
+}
+
+// ParseByteSize parses byte size string like '64MiB' to bytes count, unit is case-insensitive
+func ParseByteSize(size string) (int64, error) {
+	size = strings.TrimSpace(size)
+	unitIndex := strings.IndexFunc(size, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if unitIndex < 0 {
+		unitIndex = len(size)
+	}
+	number, err := strconv.ParseFloat(size[:unitIndex], 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid byte size '%s'", size)
+	}
+	unit, ok := byteSizeUnits[strings.ToLower(strings.TrimSpace(size[unitIndex:]))]
+	if !ok {
+		return 0, fmt.Errorf("invalid byte size '%s', unknown unit '%s'", size, size[unitIndex:])
+	}
+	return int64(number * unit), nil
+}
@@ -0,0 +1,54 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package config
+
+import (
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:baseType=true
+// +ioc:autowire:type=config
+// +ioc:autowire:paramType=ConfigDuration
+// +ioc:autowire:constructFunc=new
+// +ioc:autowire:proxy:autoInjection=false
+
+// ConfigDuration is loaded from duration string like '3s', '1h30m', or integer nanoseconds
+type ConfigDuration time.Duration
+
+func (ci *ConfigDuration) Value() time.Duration {
+	return time.Duration(*ci)
+}
+
+func (ci *ConfigDuration) new(impl *ConfigDuration) (*ConfigDuration, error) {
+	*impl = *ci
+	return impl, nil
+}
+
+func (ci *ConfigDuration) UnmarshalYAML(node *yaml.Node) error {
+	var duration time.Duration
+	if err := node.Decode(&duration); err != nil {
+		return err
+	}
+	*ci = ConfigDuration(duration)
+	return nil
+}
+
+func FromDuration(val time.Duration) *ConfigDuration {
+	configDuration := ConfigDuration(val)
+	return &configDuration
+}
@@ -0,0 +1,55 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package config
+
+import (
+	"net/url"
+
+	"gopkg.in/yaml.v3"
+)
+
+// +ioc:autowire=true
+// +ioc:autowire:baseType=true
+// +ioc:autowire:type=config
+// +ioc:autowire:paramType=ConfigURL
+// +ioc:autowire:constructFunc=new
+// +ioc:autowire:proxy:autoInjection=false
+
+// ConfigURL is loaded from url string like 'https://user@example.com:8080/path?query=value'
+type ConfigURL url.URL
+
+func (ci *ConfigURL) Value() *url.URL {
+	u := url.URL(*ci)
+	return &u
+}
+
+func (ci *ConfigURL) new(impl *ConfigURL) (*ConfigURL, error) {
+	*impl = *ci
+	return impl, nil
+}
+
+func (ci *ConfigURL) UnmarshalYAML(node *yaml.Node) error {
+	u, err := url.Parse(node.Value)
+	if err != nil {
+		return err
+	}
+	*ci = ConfigURL(*u)
+	return nil
+}
+
+func FromURL(val *url.URL) *ConfigURL {
+	configURL := ConfigURL(*val)
+	return &configURL
+}
@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package config
+
+import (
+	"net/url"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/alibaba/ioc-golang/autowire"
+)
+
+// ConfigValue is generic config type that decodes any yaml supported target from the config path in tag, like:
+//
+//	Timeout *config.ConfigValue[time.Duration]  `config:",autowire.config.timeout"`
+//	Redis   *config.ConfigValue[RedisConfig]    `config:",autowire.config.redis"`
+//	MaxSize *config.ConfigValue[ConfigByteSize] `config:",autowire.config.max-size"`
+//
+// url.URL target is decoded from url string, and struct target is decoded with yaml tags of its fields.
+// ConfigValue is registered to config autowire when it's injected at the first time.
+type ConfigValue[T any] struct {
+	value T
+}
+
+func (ci *ConfigValue[T]) Value() T {
+	return ci.value
+}
+
+func (ci *ConfigValue[T]) UnmarshalYAML(node *yaml.Node) error {
+	if u, ok := any(&ci.value).(*url.URL); ok {
+		parsedURL, err := url.Parse(node.Value)
+		if err != nil {
+			return err
+		}
+		*u = *parsedURL
+		return nil
+	}
+	return node.Decode(&ci.value)
+}
+
+// StructDescriptor implements autowireconfig.StructDescriptorProvider
+func (ci *ConfigValue[T]) StructDescriptor() *autowire.StructDescriptor {
+	return &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return new(ConfigValue[T])
+		},
+		ParamFactory: func() interface{} {
+			return new(ConfigValue[T])
+		},
+		ConstructFunc: func(i interface{}, p interface{}) (interface{}, error) {
+			impl := i.(*ConfigValue[T])
+			*impl = *p.(*ConfigValue[T])
+			return impl, nil
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+}
+
+func FromValue[T any](val T) *ConfigValue[T] {
+	return &ConfigValue[T]{
+		value: val,
+	}
+}
@@ -6,12 +6,87 @@
 package config
 
 import (
+	urlx "net/url"
+	timex "time"
+
 	autowire "github.com/alibaba/ioc-golang/autowire"
 	normal "github.com/alibaba/ioc-golang/autowire/normal"
 	autowireconfig "github.com/alibaba/ioc-golang/extension/autowire/config"
 )
 
 func init() {
+	normal.RegisterStructDescriptor(&autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &configBool_{}
+		},
+	})
+	configBoolStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return new(ConfigBool)
+		},
+		ParamFactory: func() interface{} {
+			return new(ConfigBool)
+		},
+		ConstructFunc: func(i interface{}, p interface{}) (interface{}, error) {
+			param := p.(configBoolInterface)
+			impl := i.(*ConfigBool)
+			return param.new(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	autowireconfig.RegisterStructDescriptor(configBoolStructDescriptor)
+	normal.RegisterStructDescriptor(&autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &configByteSize_{}
+		},
+	})
+	configByteSizeStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return new(ConfigByteSize)
+		},
+		ParamFactory: func() interface{} {
+			return new(ConfigByteSize)
+		},
+		ConstructFunc: func(i interface{}, p interface{}) (interface{}, error) {
+			param := p.(configByteSizeInterface)
+			impl := i.(*ConfigByteSize)
+			return param.new(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	autowireconfig.RegisterStructDescriptor(configByteSizeStructDescriptor)
+	normal.RegisterStructDescriptor(&autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &configDuration_{}
+		},
+	})
+	configDurationStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return new(ConfigDuration)
+		},
+		ParamFactory: func() interface{} {
+			return new(ConfigDuration)
+		},
+		ConstructFunc: func(i interface{}, p interface{}) (interface{}, error) {
+			param := p.(configDurationInterface)
+			impl := i.(*ConfigDuration)
+			return param.new(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	autowireconfig.RegisterStructDescriptor(configDurationStructDescriptor)
 	normal.RegisterStructDescriptor(&autowire.StructDescriptor{
 		Factory: func() interface{} {
 			return &configFloat64_{}
@@ -156,8 +231,44 @@ func init() {
 		DisableProxy: true,
 	}
 	autowireconfig.RegisterStructDescriptor(configStringStructDescriptor)
+	normal.RegisterStructDescriptor(&autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &configURL_{}
+		},
+	})
+	configURLStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return new(ConfigURL)
+		},
+		ParamFactory: func() interface{} {
+			return new(ConfigURL)
+		},
+		ConstructFunc: func(i interface{}, p interface{}) (interface{}, error) {
+			param := p.(configURLInterface)
+			impl := i.(*ConfigURL)
+			return param.new(impl)
+		},
+		Metadata: map[string]interface{}{
+			"aop":      map[string]interface{}{},
+			"autowire": map[string]interface{}{},
+		},
+		DisableProxy: true,
+	}
+	autowireconfig.RegisterStructDescriptor(configURLStructDescriptor)
 }
 
+type configBoolInterface interface {
+	new(impl *ConfigBool) (*ConfigBool, error)
+}
+type configByteSizeInterface interface {
+	new(impl *ConfigByteSize) (*ConfigByteSize, error)
+}
+type configDurationInterface interface {
+	new(impl *ConfigDuration) (*ConfigDuration, error)
+}
+type configURLInterface interface {
+	new(impl *ConfigURL) (*ConfigURL, error)
+}
 type configSliceInterface interface {
 	new(impl *ConfigSlice) (*ConfigSlice, error)
 }
@@ -176,6 +287,30 @@ type configIntInterface interface {
 type configMapInterface interface {
 	new(impl *ConfigMap) (*ConfigMap, error)
 }
+type configBool_ struct {
+	Value_ func() bool
+}
+
+func (c *configBool_) Value() bool {
+	return c.Value_()
+}
+
+type configByteSize_ struct {
+	Value_ func() int64
+}
+
+func (c *configByteSize_) Value() int64 {
+	return c.Value_()
+}
+
+type configDuration_ struct {
+	Value_ func() timex.Duration
+}
+
+func (c *configDuration_) Value() timex.Duration {
+	return c.Value_()
+}
+
 type configFloat64_ struct {
 	Value_ func() float64
 }
@@ -224,6 +359,26 @@ func (c *configString_) Value() string {
 	return c.Value_()
 }
 
+type ConfigBoolIOCInterface interface {
+	Value() bool
+}
+
+type ConfigByteSizeIOCInterface interface {
+	Value() int64
+}
+
+type ConfigDurationIOCInterface interface {
+	Value() timex.Duration
+}
+
+type configURL_ struct {
+	Value_ func() *urlx.URL
+}
+
+func (c *configURL_) Value() *urlx.URL {
+	return c.Value_()
+}
+
 type ConfigFloat64IOCInterface interface {
 	Value() float64
 }
@@ -248,9 +403,17 @@ type ConfigStringIOCInterface interface {
 	Value() string
 }
 
+type ConfigURLIOCInterface interface {
+	Value() *urlx.URL
+}
+
+var _configBoolSDID string
+var _configByteSizeSDID string
+var _configDurationSDID string
 var _configFloat64SDID string
 var _configInt64SDID string
 var _configIntSDID string
 var _configMapSDID string
 var _configSliceSDID string
 var _configStringSDID string
+var _configURLSDID string
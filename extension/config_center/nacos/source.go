@@ -0,0 +1,322 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package nacos
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+	perrors "github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/alibaba/ioc-golang/config"
+	"github.com/alibaba/ioc-golang/logger"
+)
+
+const (
+	AddressEnvKey   = "IOC_GOLANG_CONFIG_NACOS_ADDRESS"
+	NamespaceEnvKey = "IOC_GOLANG_CONFIG_NACOS_NAMESPACE"
+	// DataIDsEnvKey is like 'app.yaml,MY_GROUP/redis.yaml', group is DEFAULT_GROUP if not set
+	DataIDsEnvKey = "IOC_GOLANG_CONFIG_NACOS_DATA_IDS"
+
+	DefaultGroup       = "DEFAULT_GROUP"
+	defaultScheme      = "http"
+	defaultPort        = 8848
+	defaultContextPath = "/nacos"
+	defaultTimeoutMs   = 3000
+
+	documentNamePrefix = "nacos:"
+	groupSeparator     = "/"
+)
+
+// ListenableConfigClient is nacos config client that can get and listen config, which is implemented by ConfigClient
+// and nacos sdk config client
+type ListenableConfigClient interface {
+	GetConfig(param vo.ConfigParam) (string, error)
+	ListenConfig(param vo.ConfigParam) error
+	CancelListenConfig(param vo.ConfigParam) error
+}
+
+// DataID is one nacos config document, which should be yaml
+type DataID struct {
+	DataID   string `yaml:"data-id"`
+	Group    string `yaml:"group"`
+	Optional bool   `yaml:"optional"`
+}
+
+func (d *DataID) documentName() string {
+	return documentNamePrefix + d.Group + groupSeparator + d.DataID
+}
+
+/*
+SourceConfig is bootstrap config of nacos config source, which is read from local config files like:
+
+	ioc-golang:
+	  config:
+	    nacos:
+	      address: 127.0.0.1:8848
+	      namespace: my-namespace
+	      data-ids:
+	        - data-id: app.yaml
+	        - data-id: redis.yaml
+	          group: PLATFORM_GROUP
+	          optional: true
+
+address, namespace and data-ids can also be set by env IOC_GOLANG_CONFIG_NACOS_ADDRESS,
+IOC_GOLANG_CONFIG_NACOS_NAMESPACE and IOC_GOLANG_CONFIG_NACOS_DATA_IDS
+*/
+type SourceConfig struct {
+	// Address is like '127.0.0.1:8848' or 'https://nacos.example.com'
+	Address string `yaml:"address"`
+	// ContextPath default: /nacos
+	ContextPath string `yaml:"context-path"`
+	Namespace   string `yaml:"namespace"`
+	// TimeoutMs is timeout of getting config, default: 3000
+	TimeoutMs     int      `yaml:"timeout-ms"`
+	DisableListen bool     `yaml:"disable-listen"`
+	DataIDs       []DataID `yaml:"data-ids"`
+}
+
+type bootstrapConfig struct {
+	IOCGolang struct {
+		Config struct {
+			Nacos SourceConfig `yaml:"nacos"`
+		} `yaml:"config"`
+	} `yaml:"ioc-golang"`
+}
+
+func (c *SourceConfig) loadFromEnv() {
+	if address := os.Getenv(AddressEnvKey); address != "" {
+		c.Address = address
+	}
+	if namespace := os.Getenv(NamespaceEnvKey); namespace != "" {
+		c.Namespace = namespace
+	}
+	if dataIDs := os.Getenv(DataIDsEnvKey); dataIDs != "" {
+		c.DataIDs = make([]DataID, 0)
+		for _, dataID := range strings.Split(dataIDs, config.EnvValueSeparator) {
+			dataID = strings.TrimSpace(dataID)
+			group := ""
+			if splited := strings.SplitN(dataID, groupSeparator, 2); len(splited) == 2 {
+				group, dataID = splited[0], splited[1]
+			}
+			c.DataIDs = append(c.DataIDs, DataID{
+				DataID: dataID,
+				Group:  group,
+			})
+		}
+	}
+}
+
+func (c *SourceConfig) validate() {
+	if c.ContextPath == "" {
+		c.ContextPath = defaultContextPath
+	}
+	if c.TimeoutMs <= 0 {
+		c.TimeoutMs = defaultTimeoutMs
+	}
+}
+
+// toClientParam converts source config to param of nacos sdk config client
+func (c *SourceConfig) toClientParam() (*Param, error) {
+	address := c.Address
+	if !strings.Contains(address, "://") {
+		address = defaultScheme + "://" + address
+	}
+	serverURL, err := url.Parse(address)
+	if err != nil {
+		return nil, perrors.Errorf("invalid nacos address %s, error = %s", c.Address, err)
+	}
+	port := uint64(defaultPort)
+	if serverURL.Port() != "" {
+		if port, err = strconv.ParseUint(serverURL.Port(), 10, 64); err != nil {
+			return nil, perrors.Errorf("invalid nacos address %s, error = %s", c.Address, err)
+		}
+	}
+	return &Param{
+		NacosClientParam: vo.NacosClientParam{
+			ClientConfig: constant.NewClientConfig(
+				constant.WithNamespaceId(c.Namespace),
+				constant.WithTimeoutMs(uint64(c.TimeoutMs)),
+				constant.WithNotLoadCacheAtStart(true),
+			),
+			ServerConfigs: []constant.ServerConfig{
+				*constant.NewServerConfig(serverURL.Hostname(), port,
+					constant.WithScheme(serverURL.Scheme),
+					constant.WithContextPath(c.ContextPath)),
+			},
+		},
+	}, nil
+}
+
+// newConfigClient creates config client of bootstrapped config source
+var newConfigClient = func(sourceConfig *SourceConfig) (ListenableConfigClient, error) {
+	param, err := sourceConfig.toClientParam()
+	if err != nil {
+		return nil, err
+	}
+	client, err := param.New(&ConfigClient{})
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// ConfigSource is config.RemoteSource that loads yaml documents from nacos config center, and listens to their
+// changes to update config at runtime
+type ConfigSource struct {
+	client        ListenableConfigClient
+	dataIDs       []DataID
+	disableListen bool
+
+	// bootstrapped is true if client and data ids are created from bootstrap config
+	bootstrapped bool
+	listening    []vo.ConfigParam
+	lock         sync.Mutex
+}
+
+// NewConfigSource creates config source with given client like ConfigClient, which should be added to config.Load
+// with config.WithRemoteSource
+func NewConfigSource(client ListenableConfigClient, dataIDs ...DataID) *ConfigSource {
+	return &ConfigSource{
+		client:  client,
+		dataIDs: dataIDs,
+	}
+}
+
+// NewBootstrapConfigSource creates config source whose client and data ids are created from bootstrap config
+// SourceConfig, which should be added to config.Load with config.WithRemoteSource, like:
+//
+//	ioc.Load(config.WithRemoteSource(nacos.NewBootstrapConfigSource()))
+func NewBootstrapConfigSource() *ConfigSource {
+	return &ConfigSource{}
+}
+
+func (s *ConfigSource) Load(bootstrap config.Config) ([]*config.RemoteDocument, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.client == nil || s.bootstrapped {
+		sourceConfig, err := parseSourceConfig(bootstrap)
+		if err != nil {
+			return nil, err
+		}
+		// client is recreated with new bootstrap config
+		s.cancelListening()
+		if closer, ok := s.client.(interface{ CloseClient() }); ok {
+			closer.CloseClient()
+		}
+		if sourceConfig.Address == "" {
+			// not enabled
+			s.client, s.dataIDs, s.bootstrapped = nil, nil, false
+			return nil, nil
+		}
+		if s.client, err = newConfigClient(sourceConfig); err != nil {
+			s.client, s.dataIDs, s.bootstrapped = nil, nil, false
+			return nil, perrors.Errorf("create nacos config client failed, error = %s", err)
+		}
+		s.dataIDs = sourceConfig.DataIDs
+		s.disableListen = sourceConfig.DisableListen
+		s.bootstrapped = true
+	}
+
+	documents := make([]*config.RemoteDocument, 0, len(s.dataIDs))
+	for i := range s.dataIDs {
+		dataID := &s.dataIDs[i]
+		if dataID.Group == "" {
+			dataID.Group = DefaultGroup
+		}
+		contents, err := s.client.GetConfig(vo.ConfigParam{
+			DataId: dataID.DataID,
+			Group:  dataID.Group,
+		})
+		if err != nil {
+			if dataID.Optional {
+				logger.Blue("[Nacos Config Source] Skip optional config %s, error = %s", dataID.documentName(), err)
+				continue
+			}
+			return nil, perrors.Errorf("get nacos config %s failed, error = %s", dataID.documentName(), err)
+		}
+		documents = append(documents, &config.RemoteDocument{
+			Name:     dataID.documentName(),
+			Contents: []byte(contents),
+		})
+	}
+	return documents, nil
+}
+
+func (s *ConfigSource) Watch(onChange func(document *config.RemoteDocument)) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cancelListening()
+	if s.client == nil || s.disableListen {
+		return nil
+	}
+	for _, dataID := range s.dataIDs {
+		documentName := dataID.documentName()
+		param := vo.ConfigParam{
+			DataId: dataID.DataID,
+			Group:  dataID.Group,
+			OnChange: func(namespace, group, dataId, data string) {
+				logger.Blue("[Nacos Config Source] Config %s changed", documentName)
+				onChange(&config.RemoteDocument{
+					Name:     documentName,
+					Contents: []byte(data),
+				})
+			},
+		}
+		if err := s.client.ListenConfig(param); err != nil {
+			return perrors.Errorf("listen nacos config %s failed, error = %s", documentName, err)
+		}
+		s.listening = append(s.listening, param)
+	}
+	return nil
+}
+
+// Close stops listening to config changes
+func (s *ConfigSource) Close() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.cancelListening()
+}
+
+func (s *ConfigSource) cancelListening() {
+	for _, param := range s.listening {
+		if err := s.client.CancelListenConfig(param); err != nil {
+			logger.Red("[Nacos Config Source] Cancel listening config %s/%s failed, error = %s", param.Group, param.DataId, err)
+		}
+	}
+	s.listening = nil
+}
+
+func parseSourceConfig(bootstrap config.Config) (*SourceConfig, error) {
+	bootstrapContents, err := yaml.Marshal(bootstrap)
+	if err != nil {
+		return nil, err
+	}
+	parsedBootstrapConfig := &bootstrapConfig{}
+	if err := yaml.Unmarshal(bootstrapContents, parsedBootstrapConfig); err != nil {
+		return nil, fmt.Errorf("invalid nacos config source bootstrap config, error = %s", err)
+	}
+	sourceConfig := &parsedBootstrapConfig.IOCGolang.Config.Nacos
+	sourceConfig.loadFromEnv()
+	sourceConfig.validate()
+	return sourceConfig, nil
+}
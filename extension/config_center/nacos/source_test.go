@@ -0,0 +1,397 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+package nacos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	nacos_grpc_service "github.com/nacos-group/nacos-sdk-go/v2/api/grpc"
+	"github.com/nacos-group/nacos-sdk-go/v2/common/constant"
+	"github.com/nacos-group/nacos-sdk-go/v2/common/remote/rpc/rpc_request"
+	"github.com/nacos-group/nacos-sdk-go/v2/common/remote/rpc/rpc_response"
+	"github.com/nacos-group/nacos-sdk-go/v2/model"
+	"github.com/nacos-group/nacos-sdk-go/v2/util"
+	"github.com/nacos-group/nacos-sdk-go/v2/vo"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/alibaba/ioc-golang/config"
+)
+
+// fakeConfigClient keeps configs in memory, and calls listeners when config is published
+type fakeConfigClient struct {
+	configs   map[string]string
+	listeners map[string]vo.ConfigParam
+	lock      sync.Mutex
+}
+
+func newFakeConfigClient() *fakeConfigClient {
+	return &fakeConfigClient{
+		configs:   make(map[string]string),
+		listeners: make(map[string]vo.ConfigParam),
+	}
+}
+
+func (c *fakeConfigClient) publish(group, dataID, contents string) {
+	c.lock.Lock()
+	c.configs[group+groupSeparator+dataID] = contents
+	listener, ok := c.listeners[group+groupSeparator+dataID]
+	c.lock.Unlock()
+	if ok {
+		go listener.OnChange("", group, dataID, contents)
+	}
+}
+
+func (c *fakeConfigClient) GetConfig(param vo.ConfigParam) (string, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	contents, ok := c.configs[param.Group+groupSeparator+param.DataId]
+	if !ok {
+		return "", errors.New("config data not exist")
+	}
+	return contents, nil
+}
+
+func (c *fakeConfigClient) ListenConfig(param vo.ConfigParam) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.listeners[param.Group+groupSeparator+param.DataId] = param
+	return nil
+}
+
+func (c *fakeConfigClient) CancelListenConfig(param vo.ConfigParam) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.listeners, param.Group+groupSeparator+param.DataId)
+	return nil
+}
+
+func (c *fakeConfigClient) listening() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return len(c.listeners)
+}
+
+const (
+	// fakeNacosRPCPortOffset is offset of grpc port to nacos server port, nacos sdk v2 gets and listens config by grpc
+	fakeNacosRPCPortOffset = 1000
+	// fakeNacosConfigNotFound is error code of nacos server if config data not exist
+	fakeNacosConfigNotFound = 300
+)
+
+// fakeNacosServer is a local nacos server that serves config query, batch listen and change notify of nacos sdk
+type fakeNacosServer struct {
+	nacos_grpc_service.UnimplementedRequestServer
+	nacos_grpc_service.UnimplementedBiRequestStreamServer
+
+	address string
+	configs map[string]string
+	streams map[nacos_grpc_service.BiRequestStream_RequestBiStreamServer]struct{}
+	lock    sync.Mutex
+}
+
+func newFakeNacosServer(t *testing.T) *fakeNacosServer {
+	lst, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &fakeNacosServer{
+		address: fmt.Sprintf("127.0.0.1:%d", lst.Addr().(*net.TCPAddr).Port-fakeNacosRPCPortOffset),
+		configs: make(map[string]string),
+		streams: make(map[nacos_grpc_service.BiRequestStream_RequestBiStreamServer]struct{}),
+	}
+	server := grpc.NewServer()
+	nacos_grpc_service.RegisterRequestServer(server, s)
+	nacos_grpc_service.RegisterBiRequestStreamServer(server, s)
+	go func() {
+		_ = server.Serve(lst)
+	}()
+	t.Cleanup(server.Stop)
+	return s
+}
+
+// publish sets config and notifies connected clients of the change
+func (s *fakeNacosServer) publish(group, dataID, contents string) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.configs[group+groupSeparator+dataID] = contents
+	notifyRequest := rpc_request.NewConfigChangeNotifyRequest(group, dataID, "")
+	for stream := range s.streams {
+		payload, err := toFakeNacosPayload(notifyRequest.GetRequestType(), notifyRequest)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(payload); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *fakeNacosServer) Request(_ context.Context, payload *nacos_grpc_service.Payload) (*nacos_grpc_service.Payload, error) {
+	var response rpc_response.IResponse
+	switch payload.GetMetadata().GetType() {
+	case "ServerCheckRequest":
+		response = &rpc_response.ServerCheckResponse{Response: newFakeNacosSuccessResponse(), ConnectionId: "fake-connection"}
+	case "HealthCheckRequest":
+		response = &rpc_response.HealthCheckResponse{Response: newFakeNacosSuccessResponse()}
+	case "ConfigQueryRequest":
+		request := &rpc_request.ConfigQueryRequest{ConfigRequest: rpc_request.NewConfigRequest()}
+		if err := json.Unmarshal(payload.GetBody().GetValue(), request); err != nil {
+			return nil, err
+		}
+		s.lock.Lock()
+		contents, ok := s.configs[request.Group+groupSeparator+request.DataId]
+		s.lock.Unlock()
+		if !ok {
+			response = &rpc_response.ConfigQueryResponse{Response: &rpc_response.Response{
+				ResultCode: 500,
+				ErrorCode:  fakeNacosConfigNotFound,
+				Message:    "config data not exist",
+			}}
+			break
+		}
+		response = &rpc_response.ConfigQueryResponse{
+			Response: newFakeNacosSuccessResponse(),
+			Content:  contents,
+			Md5:      util.Md5(contents),
+		}
+	case "ConfigBatchListenRequest":
+		request := &rpc_request.ConfigBatchListenRequest{ConfigRequest: rpc_request.NewConfigRequest()}
+		if err := json.Unmarshal(payload.GetBody().GetValue(), request); err != nil {
+			return nil, err
+		}
+		changedConfigs := make([]model.ConfigContext, 0)
+		s.lock.Lock()
+		for _, listenContext := range request.ConfigListenContexts {
+			contents, ok := s.configs[listenContext.Group+groupSeparator+listenContext.DataId]
+			if ok && util.Md5(contents) != listenContext.Md5 {
+				changedConfigs = append(changedConfigs, model.ConfigContext{
+					Group:  listenContext.Group,
+					DataId: listenContext.DataId,
+					Tenant: listenContext.Tenant,
+				})
+			}
+		}
+		s.lock.Unlock()
+		response = &rpc_response.ConfigChangeBatchListenResponse{
+			Response:       newFakeNacosSuccessResponse(),
+			ChangedConfigs: changedConfigs,
+		}
+	default:
+		return nil, status.Errorf(codes.Unimplemented, "request %s not implemented", payload.GetMetadata().GetType())
+	}
+	return toFakeNacosPayload(response.GetResponseType(), response)
+}
+
+func (s *fakeNacosServer) RequestBiStream(stream nacos_grpc_service.BiRequestStream_RequestBiStreamServer) error {
+	s.lock.Lock()
+	s.streams[stream] = struct{}{}
+	s.lock.Unlock()
+	defer func() {
+		s.lock.Lock()
+		delete(s.streams, stream)
+		s.lock.Unlock()
+	}()
+	for {
+		// connection setup request and responses of change notify are ignored
+		if _, err := stream.Recv(); err != nil {
+			return nil
+		}
+	}
+}
+
+func newFakeNacosSuccessResponse() *rpc_response.Response {
+	return &rpc_response.Response{ResultCode: constant.RESPONSE_CODE_SUCCESS, Success: true}
+}
+
+func toFakeNacosPayload(payloadType string, body interface{}) (*nacos_grpc_service.Payload, error) {
+	value, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	return &nacos_grpc_service.Payload{
+		Metadata: &nacos_grpc_service.Metadata{Type: payloadType},
+		Body:     &anypb.Any{Value: value},
+	}, nil
+}
+
+func TestConfigSource(t *testing.T) {
+	fakeClient := newFakeConfigClient()
+	fakeClient.publish(DefaultGroup, "app.yaml", "app:\n  name: nacos\n  port: 8080\n")
+	var sourceConfig *SourceConfig
+	defer func(originNewConfigClient func(*SourceConfig) (ListenableConfigClient, error)) {
+		newConfigClient = originNewConfigClient
+	}(newConfigClient)
+	newConfigClient = func(c *SourceConfig) (ListenableConfigClient, error) {
+		sourceConfig = c
+		return fakeClient, nil
+	}
+	source := NewBootstrapConfigSource()
+
+	bootstrapFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, os.WriteFile(bootstrapFile, []byte(`app:
+  name: local
+  port: 80
+ioc-golang:
+  config:
+    nacos:
+      address: 127.0.0.1:8848
+      data-ids:
+        - data-id: app.yaml
+        - data-id: redis.yaml
+          group: PLATFORM_GROUP
+          optional: true
+`), 0644))
+	assert.Nil(t, config.Load(config.WithAbsPath(bootstrapFile), config.WithRemoteSource(source)))
+	defer func() {
+		// stop listening by loading config without nacos bootstrap config
+		emptyFile := filepath.Join(t.TempDir(), "empty.yaml")
+		assert.Nil(t, os.WriteFile(emptyFile, []byte("app:\n  name: local\n"), 0644))
+		assert.Nil(t, config.Load(config.WithAbsPath(emptyFile), config.WithRemoteSource(source)))
+		assert.Equal(t, 0, fakeClient.listening())
+	}()
+	assert.Equal(t, "127.0.0.1:8848", sourceConfig.Address)
+	assert.Equal(t, 2, fakeClient.listening())
+
+	name := ""
+	assert.Nil(t, config.LoadConfigByPrefix("app.name", &name))
+	assert.Equal(t, "nacos", name)
+	assert.Equal(t, "nacos:DEFAULT_GROUP/app.yaml:2", config.GetOrigin("app.name"))
+
+	events := make(chan []*config.ChangeEvent, 2)
+	removeListener := config.AddChangeListener("", func(changeEvents []*config.ChangeEvent) {
+		events <- changeEvents
+	})
+	defer removeListener()
+
+	fakeClient.publish("PLATFORM_GROUP", "redis.yaml", "redis:\n  address: localhost:6379\n")
+	select {
+	case changeEvents := <-events:
+		assert.Equal(t, []*config.ChangeEvent{
+			{Path: "redis.address", Type: config.ChangeTypeAdded, NewValue: "localhost:6379"},
+		}, changeEvents)
+	case <-time.After(time.Second * 5):
+		t.Fatal("config change is not notified")
+	}
+	address := ""
+	assert.Nil(t, config.LoadConfigByPrefix("redis.address", &address))
+	assert.Equal(t, "localhost:6379", address)
+
+	fakeClient.publish(DefaultGroup, "app.yaml", "app:\n  name: nacos-changed\n  port: 8080\n")
+	select {
+	case changeEvents := <-events:
+		assert.Equal(t, []*config.ChangeEvent{
+			{Path: "app.name", Type: config.ChangeTypeModified, OldValue: "nacos", NewValue: "nacos-changed"},
+		}, changeEvents)
+	case <-time.After(time.Second * 5):
+		t.Fatal("config change is not notified")
+	}
+}
+
+func TestConfigSourceWithNacosServer(t *testing.T) {
+	server := newFakeNacosServer(t)
+	assert.Nil(t, server.publish(DefaultGroup, "app.yaml", "app:\n  name: nacos\n  port: 8080\n"))
+	source := NewBootstrapConfigSource()
+
+	bootstrapFile := filepath.Join(t.TempDir(), "config.yaml")
+	assert.Nil(t, os.WriteFile(bootstrapFile, []byte(fmt.Sprintf(`app:
+  name: local
+  port: 80
+ioc-golang:
+  config:
+    nacos:
+      address: %s
+      data-ids:
+        - data-id: app.yaml
+        - data-id: redis.yaml
+          group: PLATFORM_GROUP
+          optional: true
+`, server.address)), 0644))
+	assert.Nil(t, config.Load(config.WithAbsPath(bootstrapFile), config.WithRemoteSource(source)))
+	defer func() {
+		// close nacos client by loading config without nacos bootstrap config
+		emptyFile := filepath.Join(t.TempDir(), "empty.yaml")
+		assert.Nil(t, os.WriteFile(emptyFile, []byte("app:\n  name: local\n"), 0644))
+		assert.Nil(t, config.Load(config.WithAbsPath(emptyFile), config.WithRemoteSource(source)))
+	}()
+
+	name := ""
+	assert.Nil(t, config.LoadConfigByPrefix("app.name", &name))
+	assert.Equal(t, "nacos", name)
+	assert.Equal(t, "nacos:DEFAULT_GROUP/app.yaml:2", config.GetOrigin("app.name"))
+
+	events := make(chan []*config.ChangeEvent, 2)
+	removeListener := config.AddChangeListener("", func(changeEvents []*config.ChangeEvent) {
+		events <- changeEvents
+	})
+	defer removeListener()
+
+	assert.Nil(t, server.publish("PLATFORM_GROUP", "redis.yaml", "redis:\n  address: localhost:6379\n"))
+	select {
+	case changeEvents := <-events:
+		assert.Equal(t, []*config.ChangeEvent{
+			{Path: "redis.address", Type: config.ChangeTypeAdded, NewValue: "localhost:6379"},
+		}, changeEvents)
+	case <-time.After(time.Second * 15):
+		t.Fatal("config change is not notified")
+	}
+	address := ""
+	assert.Nil(t, config.LoadConfigByPrefix("redis.address", &address))
+	assert.Equal(t, "localhost:6379", address)
+	assert.Equal(t, "nacos:PLATFORM_GROUP/redis.yaml:2", config.GetOrigin("redis.address"))
+}
+
+func TestSourceConfigToClientParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		want    constant.ServerConfig
+	}{
+		{
+			name:    "host and port",
+			address: "127.0.0.1:8848",
+			want:    constant.ServerConfig{Scheme: "http", IpAddr: "127.0.0.1", Port: 8848, ContextPath: defaultContextPath},
+		},
+		{
+			name:    "url without port",
+			address: "https://nacos.example.com",
+			want:    constant.ServerConfig{Scheme: "https", IpAddr: "nacos.example.com", Port: defaultPort, ContextPath: defaultContextPath},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sourceConfig := &SourceConfig{Address: tt.address, Namespace: "ns"}
+			sourceConfig.validate()
+			param, err := sourceConfig.toClientParam()
+			assert.Nil(t, err)
+			assert.Equal(t, []constant.ServerConfig{tt.want}, param.ServerConfigs)
+			assert.Equal(t, "ns", param.ClientConfig.NamespaceId)
+			assert.Equal(t, uint64(defaultTimeoutMs), param.ClientConfig.TimeoutMs)
+		})
+	}
+}
@@ -18,11 +18,18 @@
 package boot
 
 import (
+	_ "github.com/alibaba/ioc-golang/extension/aop/breaker"
+	_ "github.com/alibaba/ioc-golang/extension/aop/cache"
 	_ "github.com/alibaba/ioc-golang/extension/aop/call"
+	_ "github.com/alibaba/ioc-golang/extension/aop/config"
 	_ "github.com/alibaba/ioc-golang/extension/aop/dynamic_plugin"
+	_ "github.com/alibaba/ioc-golang/extension/aop/limit"
 	_ "github.com/alibaba/ioc-golang/extension/aop/list"
 	_ "github.com/alibaba/ioc-golang/extension/aop/log"
 	_ "github.com/alibaba/ioc-golang/extension/aop/monitor"
+	_ "github.com/alibaba/ioc-golang/extension/aop/recovery"
+	_ "github.com/alibaba/ioc-golang/extension/aop/retry"
+	_ "github.com/alibaba/ioc-golang/extension/aop/timeout"
 	_ "github.com/alibaba/ioc-golang/extension/aop/trace"
 	_ "github.com/alibaba/ioc-golang/extension/aop/transaction"
 	_ "github.com/alibaba/ioc-golang/extension/aop/watch"
@@ -16,11 +16,17 @@
 package cli
 
 import (
+	_ "github.com/alibaba/ioc-golang/extension/aop/breaker/cli"
+	_ "github.com/alibaba/ioc-golang/extension/aop/cache/cli"
 	_ "github.com/alibaba/ioc-golang/extension/aop/call/cli"
+	_ "github.com/alibaba/ioc-golang/extension/aop/config/cli"
 	_ "github.com/alibaba/ioc-golang/extension/aop/dynamic_plugin/cli"
+	_ "github.com/alibaba/ioc-golang/extension/aop/limit/cli"
 	_ "github.com/alibaba/ioc-golang/extension/aop/list/cli"
 	_ "github.com/alibaba/ioc-golang/extension/aop/log/cli"
 	_ "github.com/alibaba/ioc-golang/extension/aop/monitor/cli"
+	_ "github.com/alibaba/ioc-golang/extension/aop/retry/cli"
+	_ "github.com/alibaba/ioc-golang/extension/aop/timeout/cli"
 	_ "github.com/alibaba/ioc-golang/extension/aop/trace/cli"
 	_ "github.com/alibaba/ioc-golang/extension/aop/transaction/cli"
 	_ "github.com/alibaba/ioc-golang/extension/aop/watch/cli"
@@ -0,0 +1,38 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	_ "github.com/alibaba/ioc-golang/extension/imports/cli"
+)
+
+func TestGenDestroyFunc(t *testing.T) {
+	generatedFile := filepath.Join("testdata", "destroy", "zz_generated.ioc.go")
+	defer os.Remove(generatedFile)
+
+	assert.Nil(t, genCMD.RunE(genCMD, []string{"register", "paths=./testdata/destroy"}))
+	contents, err := os.ReadFile(generatedFile)
+	assert.Nil(t, err)
+	assert.Contains(t, string(contents), `DestroyFunc: func(i interface{}) {
+			i.(*Server).Close()
+		},`)
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"sigs.k8s.io/controller-tools/pkg/markers"
+
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin"
+)
+
+// aopMarkerPrefix is prefix of struct marker '+ioc:aop:<name>', which makes aop.Pointcut with Marker <name> match
+// methods of the struct
+const aopMarkerPrefix = "+ioc:aop:"
+
+// generateAOPMarkerMetadata generates '"<name>": true' in aop metadata for all '+ioc:aop:<name>' markers of struct,
+// except those handled by code generation plugins of AOP with the same name
+func generateAOPMarkerMetadata(info *markers.TypeInfo, allImplPlugins []plugin.CodeGeneratorPluginForOneStruct, c plugin.CodeWriter) {
+	pluginNames := make(map[string]bool)
+	for _, pluginImpl := range allImplPlugins {
+		if pluginImpl.Type() == plugin.AOP {
+			pluginNames[pluginImpl.Name()] = true
+		}
+	}
+	for _, name := range getAOPMarkerNames(info) {
+		if !pluginNames[name] {
+			c.Linef(`"%s": true,`, name)
+		}
+	}
+}
+
+// getAOPMarkerNames returns names of '+ioc:aop:<name>' markers in comments above type declaration, markers of AOP
+// without registered marker definition are not collected by controller-tools, so comments are parsed directly
+func getAOPMarkerNames(info *markers.TypeInfo) []string {
+	names := make([]string, 0)
+	found := make(map[string]bool)
+	for _, commentGroup := range getTypeCommentGroups(info) {
+		for _, comment := range commentGroup.List {
+			text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+			if !strings.HasPrefix(text, aopMarkerPrefix) {
+				continue
+			}
+			name := strings.TrimPrefix(text, aopMarkerPrefix)
+			if index := strings.IndexAny(name, ":="); index >= 0 {
+				name = name[:index]
+			}
+			if name != "" && !found[name] {
+				found[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// getTypeCommentGroups returns comment groups between type declaration and the previous declaration in file, and
+// doc of type spec if it is declared in group
+func getTypeCommentGroups(info *markers.TypeInfo) []*ast.CommentGroup {
+	commentGroups := make([]*ast.CommentGroup, 0)
+	if info.RawFile == nil || info.RawDecl == nil {
+		return commentGroups
+	}
+	start := info.RawFile.Name.End()
+	for _, decl := range info.RawFile.Decls {
+		if decl.End() < info.RawDecl.Pos() && decl.End() > start {
+			start = decl.End()
+		}
+	}
+	for _, commentGroup := range info.RawFile.Comments {
+		if commentGroup.Pos() > start && commentGroup.End() < info.RawDecl.Pos() {
+			commentGroups = append(commentGroups, commentGroup)
+		}
+	}
+	if info.RawDecl.Lparen != token.NoPos && info.RawSpec != nil && info.RawSpec.Doc != nil {
+		commentGroups = append(commentGroups, info.RawSpec.Doc)
+	}
+	return commentGroups
+}
@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package generator
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+const aopMarkerTestFile = `package service
+
+// +ioc:aop:other
+type Other struct {
+}
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:aop:my-aop
+// +ioc:aop:retry:method=Get,maxAttempts=3
+
+// Service is marked with aop markers
+// +ioc:aop:audit=true
+type Service struct {
+}
+
+type (
+	// +ioc:aop:grouped
+	Grouped struct {
+	}
+)
+`
+
+func TestGetAOPMarkerNames(t *testing.T) {
+	file, err := parser.ParseFile(token.NewFileSet(), "service.go", aopMarkerTestFile, parser.ParseComments)
+	assert.Nil(t, err)
+	typeInfo := func(index int) *markers.TypeInfo {
+		decl := file.Decls[index].(*ast.GenDecl)
+		return &markers.TypeInfo{
+			RawFile: file,
+			RawDecl: decl,
+			RawSpec: decl.Specs[0].(*ast.TypeSpec),
+		}
+	}
+	assert.Equal(t, []string{"other"}, getAOPMarkerNames(typeInfo(0)))
+	assert.Equal(t, []string{"my-aop", "retry", "audit"}, getAOPMarkerNames(typeInfo(1)))
+	assert.Equal(t, []string{"grouped"}, getAOPMarkerNames(typeInfo(2)))
+}
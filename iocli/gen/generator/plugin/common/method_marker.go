@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"reflect"
+
+	"sigs.k8s.io/controller-tools/pkg/loader"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+
+	"github.com/alibaba/ioc-golang/iocli/gen/generator/plugin"
+)
+
+const (
+	methodFieldName = "Method"
+	ruleTag         = "rule"
+)
+
+/*
+NewMethodMarkerDefinition defines marker of AOP rule of one method, like '+ioc:aop:retry:method=GetUser,maxAttempts=5'.
+args is struct with string field 'Method' of marker 'method', and other fields whose tag 'rule' is key of the
+rule in AOP config, like:
+
+	type retryMarkerArgs struct {
+		Method      string   `marker:"method"`
+		MaxAttempts int      `marker:"maxAttempts,optional" rule:"max-attempts"`
+		Jitter      *float64 `marker:"jitter,optional" rule:"jitter"`
+	}
+
+Zero value fields are not generated, use pointer field if zero value of it should be set by marker.
+*/
+func NewMethodMarkerDefinition(annotation string, args interface{}) *markers.Definition {
+	return markers.Must(markers.MakeDefinition(annotation, markers.DescribesType, args))
+}
+
+/*
+MethodMarkerPlugin is embedded by code generation plugin of AOP configured by markers of NewMethodMarkerDefinition,
+it generates rules of marked methods as metadata of the AOP, like:
+
+	"retry": map[string]map[string]interface{}{"GetUser": {"max-attempts": 5}}
+*/
+type MethodMarkerPlugin struct {
+	name          string
+	annotation    string
+	methodMarkers []reflect.Value
+}
+
+func NewMethodMarkerPlugin(name, annotation string) MethodMarkerPlugin {
+	return MethodMarkerPlugin{
+		name:          name,
+		annotation:    annotation,
+		methodMarkers: make([]reflect.Value, 0),
+	}
+}
+
+func (p *MethodMarkerPlugin) Name() string {
+	return p.name
+}
+
+func (p *MethodMarkerPlugin) Type() plugin.Type {
+	return plugin.AOP
+}
+
+func (p *MethodMarkerPlugin) Init(info markers.TypeInfo) {
+	for _, v := range info.Markers[p.annotation] {
+		markerArgs := reflect.ValueOf(v)
+		if markerArgs.Kind() != reflect.Struct {
+			continue
+		}
+		if method := markerArgs.FieldByName(methodFieldName); method.Kind() == reflect.String && method.String() != "" {
+			p.methodMarkers = append(p.methodMarkers, markerArgs)
+		}
+	}
+}
+
+// GenerateSDMetadataForOneStruct generates fields of marker args that are not zero or nil, with keys of their tag 'rule'
+func (p *MethodMarkerPlugin) GenerateSDMetadataForOneStruct(root *loader.Package, c plugin.CodeWriter) {
+	if len(p.methodMarkers) == 0 {
+		return
+	}
+	c.Linef(`"%s": map[string]map[string]interface{}{`, p.name)
+	for _, markerArgs := range p.methodMarkers {
+		c.Linef(`"%s": {`, markerArgs.FieldByName(methodFieldName).String())
+		for i := 0; i < markerArgs.NumField(); i++ {
+			key := markerArgs.Type().Field(i).Tag.Get(ruleTag)
+			if key == "" || markerArgs.Field(i).IsZero() {
+				continue
+			}
+			generateRuleValue(c, key, markerArgs.Field(i))
+		}
+		c.Line(`},`)
+	}
+	c.Line(`},`)
+}
+
+func (p *MethodMarkerPlugin) GenerateInFileForOneStruct(root *loader.Package, c plugin.CodeWriter) {
+}
+
+func generateRuleValue(c plugin.CodeWriter, key string, value reflect.Value) {
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.String:
+		c.Linef(`"%s": %q,`, key, value.String())
+	case reflect.Slice:
+		c.Linef(`"%s": %s{`, key, value.Type())
+		for i := 0; i < value.Len(); i++ {
+			c.Linef(`%#v,`, value.Index(i).Interface())
+		}
+		c.Line(`},`)
+	default:
+		c.Linef(`"%s": %v,`, key, value.Interface())
+	}
+}
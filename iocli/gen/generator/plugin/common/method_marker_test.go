@@ -0,0 +1,100 @@
+/*
+ * Copyright (c) 2022, Alibaba Group;
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"sigs.k8s.io/controller-tools/pkg/markers"
+)
+
+const testAnnotation = "ioc:aop:test"
+
+type testMarkerArgs struct {
+	Method      string   `marker:"method"`
+	MaxAttempts int      `marker:"maxAttempts,optional" rule:"max-attempts"`
+	Jitter      *float64 `marker:"jitter,optional" rule:"jitter"`
+	Block       bool     `marker:"block,optional" rule:"block"`
+	Backoff     string   `marker:"backoff,optional" rule:"backoff"`
+	RetryOn     []string `marker:"retryOn,optional" rule:"retry-on"`
+	Ignored     string   `marker:"ignored,optional"`
+}
+
+type testCodeWriter struct {
+	lines []string
+}
+
+func (w *testCodeWriter) Line(line string) {
+	w.lines = append(w.lines, line)
+}
+
+func (w *testCodeWriter) Linef(line string, args ...interface{}) {
+	w.lines = append(w.lines, fmt.Sprintf(line, args...))
+}
+
+func (w *testCodeWriter) NeedImport(importPath string) string {
+	return ""
+}
+
+func TestMethodMarkerPlugin(t *testing.T) {
+	registry := &markers.Registry{}
+	assert.Nil(t, registry.Register(NewMethodMarkerDefinition(testAnnotation, testMarkerArgs{})))
+	definition := registry.Lookup("+"+testAnnotation, markers.DescribesType)
+	parse := func(marker string) interface{} {
+		args, err := definition.Parse(marker)
+		assert.Nil(t, err)
+		return args
+	}
+
+	p := NewMethodMarkerPlugin("test", testAnnotation)
+	assert.Equal(t, "test", p.Name())
+	p.Init(markers.TypeInfo{
+		Markers: markers.MarkerValues{
+			testAnnotation: []interface{}{
+				parse(`+ioc:aop:test:method=Get,maxAttempts=3,jitter=0.5,block=true,backoff=50ms,retryOn={timeout,"^refused$"},ignored=x`),
+				parse(`+ioc:aop:test:method=List,jitter=0`),
+				parse(`+ioc:aop:test:method=""`),
+			},
+		},
+	})
+	w := &testCodeWriter{}
+	p.GenerateSDMetadataForOneStruct(nil, w)
+	assert.Equal(t, []string{
+		`"test": map[string]map[string]interface{}{`,
+		`"Get": {`,
+		`"max-attempts": 3,`,
+		`"jitter": 0.5,`,
+		`"block": true,`,
+		`"backoff": "50ms",`,
+		`"retry-on": []string{`,
+		`"timeout",`,
+		`"^refused$",`,
+		`},`,
+		`},`,
+		`"List": {`,
+		`"jitter": 0,`,
+		`},`,
+		`},`,
+	}, w.lines)
+
+	w = &testCodeWriter{}
+	empty := NewMethodMarkerPlugin("test", testAnnotation)
+	empty.Init(markers.TypeInfo{})
+	empty.GenerateSDMetadataForOneStruct(nil, w)
+	assert.Empty(t, w.lines)
+}
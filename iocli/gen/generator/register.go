@@ -255,6 +255,11 @@ func (c *copyMethodMaker) generateMethodsFor(ctx *genall.GenerationContext, root
 			constructFunc = info.Markers["ioc:autowire:constructFunc"][0].(string)
 		}
 
+		destroyFunc := ""
+		if len(info.Markers["ioc:autowire:destroyFunc"]) != 0 {
+			destroyFunc = info.Markers["ioc:autowire:destroyFunc"][0].(string)
+		}
+
 		proxyEnable := true
 		if len(info.Markers["ioc:autowire:proxy"]) != 0 {
 			proxyEnable = info.Markers["ioc:autowire:proxy"][0].(bool)
@@ -358,6 +363,13 @@ func (c *copyMethodMaker) generateMethodsFor(ctx *genall.GenerationContext, root
 			constructFunctionInfoNames = append(constructFunctionInfoNames, info.Name)
 		}
 
+		// 4.1 gen destroy function, which is a method of struct without param
+		if destroyFunc != "" {
+			c.Linef(`DestroyFunc: func(i interface{}) {
+	i.(*%s).%s()
+},`, info.Name, destroyFunc)
+		}
+
 		// 5. gen metadata
 		c.Line(`Metadata: map[string]interface{}{`)
 		// 5.1 gen aop plugins metadata
@@ -367,6 +379,7 @@ func (c *copyMethodMaker) generateMethodsFor(ctx *genall.GenerationContext, root
 				pluginImpl.GenerateSDMetadataForOneStruct(root, c)
 			}
 		}
+		generateAOPMarkerMetadata(info, allImplPlugins, c)
 		c.Line(`},`)
 
 		// 5.2 gen autowire plugins metadata
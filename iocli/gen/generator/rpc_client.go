@@ -101,6 +101,7 @@ func genIOCRPCClientStub(ctx *genall.GenerationContext, root *loader.Package, rp
 				pluginImpl.GenerateSDMetadataForOneStruct(root, c)
 			}
 		}
+		generateAOPMarkerMetadata(info, allImplPlugins, c)
 		c.Line(`},`)
 
 		// 5.2 gen autowire plugins metadata
@@ -82,6 +82,18 @@ func (m *iocGolangAutowireConstructFuncMarker) GetMarkerDefinition() *markers.De
 // +ioc:autowire:proxy=false
 // +ioc:autowire:implements=github.com/alibaba/ioc-golang/iocli/gen/marker.DefinitionGetter
 
+type iocGolangAutowireDestroyFuncMarker struct {
+}
+
+func (m *iocGolangAutowireDestroyFuncMarker) GetMarkerDefinition() *markers.Definition {
+	return markers.Must(markers.MakeDefinition("ioc:autowire:destroyFunc", markers.DescribesType, ""))
+}
+
+// +ioc:autowire=true
+// +ioc:autowire:type=allimpls
+// +ioc:autowire:proxy=false
+// +ioc:autowire:implements=github.com/alibaba/ioc-golang/iocli/gen/marker.DefinitionGetter
+
 type iocGolangAutowireBaseTypeMarker struct {
 }
 
@@ -102,6 +102,24 @@ func init() {
 	}
 	allimpls.RegisterStructDescriptor(iocGolangAutowireConstructFuncMarkerStructDescriptor)
 	var _ marker.DefinitionGetter = &iocGolangAutowireConstructFuncMarker{}
+	iocGolangAutowireDestroyFuncMarkerStructDescriptor := &autowire.StructDescriptor{
+		Factory: func() interface{} {
+			return &iocGolangAutowireDestroyFuncMarker{}
+		},
+		Metadata: map[string]interface{}{
+			"aop": map[string]interface{}{},
+			"autowire": map[string]interface{}{
+				"common": map[string]interface{}{
+					"implements": []interface{}{
+						new(marker.DefinitionGetter),
+					},
+				},
+			},
+		},
+		DisableProxy: true,
+	}
+	allimpls.RegisterStructDescriptor(iocGolangAutowireDestroyFuncMarkerStructDescriptor)
+	var _ marker.DefinitionGetter = &iocGolangAutowireDestroyFuncMarker{}
 	iocGolangAutowireBaseTypeMarkerStructDescriptor := &autowire.StructDescriptor{
 		Factory: func() interface{} {
 			return &iocGolangAutowireBaseTypeMarker{}
@@ -181,6 +199,7 @@ var _iocGolangAutowireTypeMarkerSDID string
 var _iocGolangAutowireParamMarkerSDID string
 var _iocGolangAutowireParamLoaderMarkerSDID string
 var _iocGolangAutowireConstructFuncMarkerSDID string
+var _iocGolangAutowireDestroyFuncMarkerSDID string
 var _iocGolangAutowireBaseTypeMarkerSDID string
 var _iocGolangAutowireAliasMarkerSDID string
 var _iocGolangAutowireProxyMarkerSDID string
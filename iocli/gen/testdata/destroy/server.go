@@ -0,0 +1,14 @@
+package destroy
+
+// +ioc:autowire=true
+// +ioc:autowire:type=singleton
+// +ioc:autowire:proxy=false
+// +ioc:autowire:destroyFunc=Close
+
+type Server struct {
+	closed bool
+}
+
+func (s *Server) Close() {
+	s.closed = true
+}